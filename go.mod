@@ -1,5 +1,7 @@
 module github.com/meads/datastructures
 
+go 1.23
+
 require (
 	github.com/gorilla/handlers v1.4.0
 	github.com/gorilla/mux v1.7.0
@@ -0,0 +1,51 @@
+package cache
+
+import "time"
+
+// Config holds the optional settings shared by LRUCache and LFUCache
+type Config[K comparable, V any] struct {
+	// OnEvict is called with each entry dropped to make room for another, or found to have expired
+	OnEvict func(key K, value V)
+	// TTL is how long entries stored with Put remain valid; zero means they never expire
+	TTL time.Duration
+	// Now reports the current time, replace it to control expiry in tests. time.Now is used when nil
+	Now func() time.Time
+}
+
+// Stats counts the lookups made with Get
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// entry is the payload of a linkedlist.Node in either cache
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time // zero when the entry never expires
+	freq    int       // only used by LFUCache
+}
+
+func (c *Config[K, V]) normalize() {
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+}
+
+// expiry returns the time an entry stored now with 'ttl' expires, or the zero time when 'ttl' is not positive
+func (c *Config[K, V]) expiry(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return c.Now().Add(ttl)
+}
+
+func (c *Config[K, V]) expired(e entry[K, V]) bool {
+	return !e.expires.IsZero() && !c.Now().Before(e.expires)
+}
+
+func (c *Config[K, V]) evicted(e entry[K, V]) {
+	if c.OnEvict != nil {
+		c.OnEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/meads/datastructures/pkg/linkedlist"
+)
+
+// LFUCache is a bounded key/value cache which evicts the least frequently used entry when full, choosing the
+// least recently used among entries used equally often. Entries are kept in one linkedlist.DoublyLinkedList per
+// use count, oldest use at the Head, so operations take constant time apart from the first eviction after a
+// Remove or expiry, which scans the use counts. It is not safe for use by multiple goroutines
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	config   Config[K, V]
+	items    map[K]*linkedlist.Node[entry[K, V]]
+	freqs    map[int]*linkedlist.DoublyLinkedList[entry[K, V]]
+	minFreq  int // lowest use count of any entry; may be stale after Remove or expiry until the next eviction
+	stats    Stats
+}
+
+// NewLFU constructs an empty instance of LFUCache holding up to 'capacity' entries, treated as 1 when less
+func NewLFU[K comparable, V any](capacity int, config Config[K, V]) *LFUCache[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	config.normalize()
+	return &LFUCache[K, V]{
+		capacity: capacity,
+		config:   config,
+		items:    make(map[K]*linkedlist.Node[entry[K, V]], capacity),
+		freqs:    make(map[int]*linkedlist.DoublyLinkedList[entry[K, V]]),
+	}
+}
+
+// Get returns the value for 'key' and true, counting a use of the entry, or the zero value and false when there
+// is no such entry or it has expired. The outcome is counted in Stats
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	node = c.use(node)
+	return node.Data.value, true
+}
+
+// Peek returns the value for 'key' like Get, without counting a use of the entry or the lookup
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.Data.value, true
+}
+
+// Put stores 'value' for 'key' with the TTL of the Config, evicting the least frequently used entry when full.
+// Replacing the value of an existing entry counts as a use
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.config.TTL)
+}
+
+// PutWithTTL stores 'value' for 'key' expiring after 'ttl', or never when 'ttl' is not positive
+func (c *LFUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	expires := c.config.expiry(ttl)
+	if node, ok := c.items[key]; ok {
+		node.Data.value = value
+		node.Data.expires = expires
+		c.use(node)
+		return
+	}
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+	c.insert(entry[K, V]{key: key, value: value, expires: expires, freq: 1})
+	c.minFreq = 1
+}
+
+// Remove deletes the entry for 'key', reporting whether there was one. OnEvict is not called
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if ok {
+		c.remove(node)
+	}
+	return ok
+}
+
+// Len returns the number of entries in the LFUCache, including expired entries which have not been looked up
+// since they expired
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Stats returns the number of hits and misses counted by Get
+func (c *LFUCache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// lookup finds the Node for 'key', removing it and calling OnEvict when it has expired
+func (c *LFUCache[K, V]) lookup(key K) (*linkedlist.Node[entry[K, V]], bool) {
+	node, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if c.config.expired(node.Data) {
+		c.remove(node)
+		c.config.evicted(node.Data)
+		return nil, false
+	}
+	return node, true
+}
+
+// use moves the entry of 'node' to the list for its' next use count, returning the Node now holding it
+func (c *LFUCache[K, V]) use(node *linkedlist.Node[entry[K, V]]) *linkedlist.Node[entry[K, V]] {
+	e := node.Data
+	c.remove(node)
+	if c.minFreq == e.freq && c.freqs[e.freq] == nil {
+		c.minFreq++
+	}
+	e.freq++
+	return c.insert(e)
+}
+
+// evict removes the least recently used entry of the lowest use count and calls OnEvict
+func (c *LFUCache[K, V]) evict() {
+	if c.freqs[c.minFreq] == nil {
+		c.minFreq = 0
+		for f := range c.freqs {
+			if c.minFreq == 0 || f < c.minFreq {
+				c.minFreq = f
+			}
+		}
+	}
+	evicted := c.freqs[c.minFreq].Head
+	c.remove(evicted)
+	c.config.evicted(evicted.Data)
+}
+
+func (c *LFUCache[K, V]) insert(e entry[K, V]) *linkedlist.Node[entry[K, V]] {
+	list, ok := c.freqs[e.freq]
+	if !ok {
+		list = &linkedlist.DoublyLinkedList[entry[K, V]]{}
+		c.freqs[e.freq] = list
+	}
+	list.InsertLast(e)
+	c.items[e.key] = list.Tail
+	return list.Tail
+}
+
+// remove unlinks 'node', dropping the list for its' use count once it is empty
+func (c *LFUCache[K, V]) remove(node *linkedlist.Node[entry[K, V]]) {
+	list := c.freqs[node.Data.freq]
+	list.Remove(node)
+	if list.Len() == 0 {
+		delete(c.freqs, node.Data.freq)
+	}
+	delete(c.items, node.Data.key)
+}
@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLFU_Get_Put_Replace(t *testing.T) {
+	sut := NewLFU[string, int](2, Config[string, int]{})
+	sut.Put("one", 1)
+	sut.Put("one", 11)
+
+	if v, ok := sut.Get("one"); !ok || v != 11 {
+		t.Errorf("expected '11' got '%d' (ok: %v)", v, ok)
+	}
+	if _, ok := sut.Get("missing"); ok {
+		t.Errorf("expected missing key to be 'false'")
+	}
+	if sut.Len() != 1 {
+		t.Errorf("expected '1' got '%d'", sut.Len())
+	}
+	if expected := (Stats{Hits: 1, Misses: 1}); sut.Stats() != expected {
+		t.Errorf("expected '%+v' got '%+v'", expected, sut.Stats())
+	}
+}
+
+func TestLFU_Evicts_Least_Frequently_Used(t *testing.T) {
+	evicted := []string{}
+	sut := NewLFU(3, Config[string, int]{OnEvict: func(k string, _ int) { evicted = append(evicted, k) }})
+	sut.Put("one", 1)
+	sut.Put("two", 2)
+	sut.Put("three", 3)
+	sut.Get("one")
+	sut.Get("one")
+	sut.Get("two")
+	sut.Peek("three")
+
+	sut.Put("four", 4) // evicts "three", used once
+	sut.Put("five", 5) // evicts "four", used once and older than "five"
+	sut.Get("five")
+	sut.Get("five")
+	sut.Get("five")
+	sut.Put("six", 6) // evicts "two", used twice
+
+	if !reflect.DeepEqual([]string{"three", "four", "two"}, evicted) {
+		t.Errorf("expected '%v' got '%v'", []string{"three", "four", "two"}, evicted)
+	}
+	for _, k := range []string{"one", "five", "six"} {
+		if _, ok := sut.Peek(k); !ok {
+			t.Errorf("expected '%s' to remain", k)
+		}
+	}
+}
+
+func TestLFU_Evicts_After_Remove_Leaves_Stale_Minimum(t *testing.T) {
+	sut := NewLFU[string, int](2, Config[string, int]{})
+	sut.Put("one", 1)
+	sut.Put("two", 2)
+	sut.Get("two")
+	sut.Get("two")
+	sut.Get("one")
+	sut.Remove("one")
+	sut.Put("three", 3)
+	sut.Get("three")
+	sut.Remove("three")
+	sut.Put("four", 4)
+	sut.Put("five", 5) // "four" has the lowest count
+
+	if _, ok := sut.Peek("four"); ok {
+		t.Errorf("expected 'four' to be evicted")
+	}
+	if _, ok := sut.Peek("two"); !ok {
+		t.Errorf("expected 'two' to remain")
+	}
+}
+
+func TestLFU_Entries_Expire(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	evicted := []string{}
+	sut := NewLFU(2, Config[string, int]{
+		TTL:     time.Minute,
+		Now:     clock.Now,
+		OnEvict: func(k string, _ int) { evicted = append(evicted, k) },
+	})
+	sut.Put("one", 1)
+	sut.PutWithTTL("two", 2, 0)
+	clock.Advance(2 * time.Minute)
+
+	if _, ok := sut.Get("one"); ok {
+		t.Errorf("expected 'one' to have expired")
+	}
+	if _, ok := sut.Get("two"); !ok {
+		t.Errorf("expected 'two' to never expire")
+	}
+	if !reflect.DeepEqual([]string{"one"}, evicted) || sut.Len() != 1 {
+		t.Errorf("expected '%v' and length '1' got '%v' and '%d'", []string{"one"}, evicted, sut.Len())
+	}
+}
@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/meads/datastructures/pkg/linkedlist"
+)
+
+// LRUCache is a bounded key/value cache which evicts the least recently used entry when full. A
+// linkedlist.DoublyLinkedList keeps the entries in order of use, most recent at the Head, and a map finds the
+// Node of a key so every operation takes constant time. It is not safe for use by multiple goroutines
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	config   Config[K, V]
+	items    map[K]*linkedlist.Node[entry[K, V]]
+	order    linkedlist.DoublyLinkedList[entry[K, V]]
+	stats    Stats
+}
+
+// NewLRU constructs an empty instance of LRUCache holding up to 'capacity' entries, treated as 1 when less
+func NewLRU[K comparable, V any](capacity int, config Config[K, V]) *LRUCache[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	config.normalize()
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		config:   config,
+		items:    make(map[K]*linkedlist.Node[entry[K, V]], capacity),
+	}
+}
+
+// Get returns the value for 'key' and true, marking the entry as most recently used, or the zero value and false
+// when there is no such entry or it has expired. The outcome is counted in Stats
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(node)
+	return node.Data.value, true
+}
+
+// Peek returns the value for 'key' like Get, without marking the entry as used or counting the lookup
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.Data.value, true
+}
+
+// Put stores 'value' for 'key' with the TTL of the Config, evicting the least recently used entry when full
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.config.TTL)
+}
+
+// PutWithTTL stores 'value' for 'key' expiring after 'ttl', or never when 'ttl' is not positive
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	e := entry[K, V]{key: key, value: value, expires: c.config.expiry(ttl)}
+	if node, ok := c.items[key]; ok {
+		node.Data = e
+		c.touch(node)
+		return
+	}
+	if len(c.items) >= c.capacity {
+		evicted := c.order.Tail
+		c.remove(evicted)
+		c.config.evicted(evicted.Data)
+	}
+	c.order.InsertFront(e)
+	c.items[key] = c.order.Head
+}
+
+// Remove deletes the entry for 'key', reporting whether there was one. OnEvict is not called
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if ok {
+		c.remove(node)
+	}
+	return ok
+}
+
+// Len returns the number of entries in the LRUCache, including expired entries which have not been looked up
+// since they expired
+func (c *LRUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Stats returns the number of hits and misses counted by Get
+func (c *LRUCache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// lookup finds the Node for 'key', removing it and calling OnEvict when it has expired
+func (c *LRUCache[K, V]) lookup(key K) (*linkedlist.Node[entry[K, V]], bool) {
+	node, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if c.config.expired(node.Data) {
+		c.remove(node)
+		c.config.evicted(node.Data)
+		return nil, false
+	}
+	return node, true
+}
+
+// touch moves 'node' to the Head of the order as the most recently used entry
+func (c *LRUCache[K, V]) touch(node *linkedlist.Node[entry[K, V]]) {
+	c.order.MoveToFront(node)
+}
+
+func (c *LRUCache[K, V]) remove(node *linkedlist.Node[entry[K, V]]) {
+	c.order.Remove(node)
+	delete(c.items, node.Data.key)
+}
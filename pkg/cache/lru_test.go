@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Config.Now replacement which only moves when advanced
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestLRU_Get_Put_Replace(t *testing.T) {
+	sut := NewLRU[string, int](2, Config[string, int]{})
+	sut.Put("one", 1)
+	sut.Put("one", 11)
+
+	if v, ok := sut.Get("one"); !ok || v != 11 {
+		t.Errorf("expected '11' got '%d' (ok: %v)", v, ok)
+	}
+	if _, ok := sut.Get("missing"); ok {
+		t.Errorf("expected missing key to be 'false'")
+	}
+	if sut.Len() != 1 {
+		t.Errorf("expected '1' got '%d'", sut.Len())
+	}
+	if expected := (Stats{Hits: 1, Misses: 1}); sut.Stats() != expected {
+		t.Errorf("expected '%+v' got '%+v'", expected, sut.Stats())
+	}
+}
+
+func TestLRU_Evicts_Least_Recently_Used(t *testing.T) {
+	evicted := []string{}
+	sut := NewLRU(2, Config[string, int]{OnEvict: func(k string, _ int) { evicted = append(evicted, k) }})
+	sut.Put("one", 1)
+	sut.Put("two", 2)
+	sut.Get("one")
+	sut.Put("three", 3)
+	sut.Peek("one")
+	sut.Put("four", 4)
+
+	if !reflect.DeepEqual([]string{"two", "one"}, evicted) {
+		t.Errorf("expected '%v' got '%v'", []string{"two", "one"}, evicted)
+	}
+	for _, k := range []string{"three", "four"} {
+		if _, ok := sut.Peek(k); !ok {
+			t.Errorf("expected '%s' to remain", k)
+		}
+	}
+}
+
+func TestLRU_Remove_Does_Not_Call_OnEvict(t *testing.T) {
+	calls := 0
+	sut := NewLRU(2, Config[string, int]{OnEvict: func(string, int) { calls++ }})
+	sut.Put("one", 1)
+
+	if !sut.Remove("one") || sut.Remove("one") {
+		t.Errorf("expected Remove to report 'true' then 'false'")
+	}
+	if calls != 0 || sut.Len() != 0 {
+		t.Errorf("expected no OnEvict calls and empty cache got '%d' calls and length '%d'", calls, sut.Len())
+	}
+}
+
+func TestLRU_Entries_Expire(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	evicted := []string{}
+	sut := NewLRU(3, Config[string, int]{
+		TTL:     time.Minute,
+		Now:     clock.Now,
+		OnEvict: func(k string, _ int) { evicted = append(evicted, k) },
+	})
+	sut.Put("one", 1)
+	sut.PutWithTTL("two", 2, time.Hour)
+	sut.PutWithTTL("three", 3, 0)
+
+	clock.Advance(time.Minute)
+
+	if _, ok := sut.Get("one"); ok {
+		t.Errorf("expected 'one' to have expired")
+	}
+	if _, ok := sut.Peek("two"); !ok {
+		t.Errorf("expected 'two' to remain")
+	}
+	clock.Advance(time.Hour)
+	if _, ok := sut.Peek("two"); ok {
+		t.Errorf("expected 'two' to have expired")
+	}
+	if _, ok := sut.Get("three"); !ok {
+		t.Errorf("expected 'three' to never expire")
+	}
+	if !reflect.DeepEqual([]string{"one", "two"}, evicted) {
+		t.Errorf("expected '%v' got '%v'", []string{"one", "two"}, evicted)
+	}
+	if expected := (Stats{Hits: 1, Misses: 1}); sut.Stats() != expected {
+		t.Errorf("expected '%+v' got '%+v'", expected, sut.Stats())
+	}
+}
@@ -0,0 +1,168 @@
+package deque
+
+import (
+	"github.com/meads/datastructures/pkg/linkedlist"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrEmpty is an error value for when a value is read from an empty deque
+	ErrEmpty = errors.New("deque is empty")
+)
+
+// Deque is a double ended queue backed by a linkedlist.DoublyLinkedList, so values are added and removed at
+// either end in constant time
+type Deque[T any] struct {
+	list linkedlist.DoublyLinkedList[T]
+}
+
+// New constructs an empty instance of Deque
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// PushFront adds the supplied data at the front of the Deque
+func (d *Deque[T]) PushFront(data T) {
+	d.list.InsertFront(data)
+}
+
+// PushBack adds the supplied data at the back of the Deque
+func (d *Deque[T]) PushBack(data T) {
+	d.list.InsertLast(data)
+}
+
+// PopFront removes and returns the value at the front of the Deque, or returns ErrEmpty
+func (d *Deque[T]) PopFront() (T, error) {
+	return d.pop(d.list.Head)
+}
+
+// PopBack removes and returns the value at the back of the Deque, or returns ErrEmpty
+func (d *Deque[T]) PopBack() (T, error) {
+	return d.pop(d.list.Tail)
+}
+
+// Front returns the value at the front of the Deque without removing it, or returns ErrEmpty
+func (d *Deque[T]) Front() (T, error) {
+	return d.peek(d.list.Head)
+}
+
+// Back returns the value at the back of the Deque without removing it, or returns ErrEmpty
+func (d *Deque[T]) Back() (T, error) {
+	return d.peek(d.list.Tail)
+}
+
+// Len returns the number of values in the Deque
+func (d *Deque[T]) Len() int {
+	return d.list.Len()
+}
+
+func (d *Deque[T]) pop(node *linkedlist.Node[T]) (T, error) {
+	data, err := d.peek(node)
+	if err != nil {
+		return data, err
+	}
+	d.list.Remove(node)
+	return data, nil
+}
+
+func (d *Deque[T]) peek(node *linkedlist.Node[T]) (T, error) {
+	if node == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return node.Data, nil
+}
+
+// SliceDeque is a double ended queue backed by a slice used as a circle, which doubles in size when full. It
+// offers the same methods as Deque for comparison
+type SliceDeque[T any] struct {
+	items  []T
+	start  int
+	length int
+}
+
+// NewSlice constructs an empty instance of SliceDeque
+func NewSlice[T any]() *SliceDeque[T] {
+	return &SliceDeque[T]{}
+}
+
+// PushFront adds the supplied data at the front of the SliceDeque
+func (d *SliceDeque[T]) PushFront(data T) {
+	d.grow()
+	d.start = (d.start - 1 + len(d.items)) % len(d.items)
+	d.items[d.start] = data
+	d.length++
+}
+
+// PushBack adds the supplied data at the back of the SliceDeque
+func (d *SliceDeque[T]) PushBack(data T) {
+	d.grow()
+	d.items[d.index(d.length)] = data
+	d.length++
+}
+
+// PopFront removes and returns the value at the front of the SliceDeque, or returns ErrEmpty
+func (d *SliceDeque[T]) PopFront() (T, error) {
+	data, err := d.Front()
+	if err != nil {
+		return data, err
+	}
+	var zero T
+	d.items[d.start] = zero
+	d.start = d.index(1)
+	d.length--
+	return data, nil
+}
+
+// PopBack removes and returns the value at the back of the SliceDeque, or returns ErrEmpty
+func (d *SliceDeque[T]) PopBack() (T, error) {
+	data, err := d.Back()
+	if err != nil {
+		return data, err
+	}
+	var zero T
+	d.items[d.index(d.length-1)] = zero
+	d.length--
+	return data, nil
+}
+
+// Front returns the value at the front of the SliceDeque without removing it, or returns ErrEmpty
+func (d *SliceDeque[T]) Front() (T, error) {
+	if d.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return d.items[d.start], nil
+}
+
+// Back returns the value at the back of the SliceDeque without removing it, or returns ErrEmpty
+func (d *SliceDeque[T]) Back() (T, error) {
+	if d.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return d.items[d.index(d.length-1)], nil
+}
+
+// Len returns the number of values in the SliceDeque
+func (d *SliceDeque[T]) Len() int {
+	return d.length
+}
+
+// index converts a position counted from the front into an index of the underlying slice
+func (d *SliceDeque[T]) index(i int) int {
+	return (d.start + i) % len(d.items)
+}
+
+// grow doubles the underlying slice when it is full, moving the values so the front is at index 0
+func (d *SliceDeque[T]) grow() {
+	if d.length < len(d.items) {
+		return
+	}
+	items := make([]T, max(1, 2*len(d.items)))
+	for i := 0; i < d.length; i++ {
+		items[i] = d.items[d.index(i)]
+	}
+	d.items = items
+	d.start = 0
+}
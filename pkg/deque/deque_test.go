@@ -0,0 +1,134 @@
+package deque
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// dequer is the set of methods shared by Deque and SliceDeque so both are held to the same tests
+type dequer[T any] interface {
+	PushFront(data T)
+	PushBack(data T)
+	PopFront() (T, error)
+	PopBack() (T, error)
+	Front() (T, error)
+	Back() (T, error)
+	Len() int
+}
+
+var implementations = map[string]func() dequer[int]{
+	"Deque":      func() dequer[int] { return New[int]() },
+	"SliceDeque": func() dequer[int] { return NewSlice[int]() },
+}
+
+func TestEmpty_Returns_ErrEmpty(t *testing.T) {
+	for name, newDeque := range implementations {
+		sut := newDeque()
+		if _, err := sut.PopFront(); err != ErrEmpty {
+			t.Errorf("%s: PopFront expected '%v' got '%v'", name, ErrEmpty, err)
+		}
+		if _, err := sut.PopBack(); err != ErrEmpty {
+			t.Errorf("%s: PopBack expected '%v' got '%v'", name, ErrEmpty, err)
+		}
+		if _, err := sut.Front(); err != ErrEmpty {
+			t.Errorf("%s: Front expected '%v' got '%v'", name, ErrEmpty, err)
+		}
+		if _, err := sut.Back(); err != ErrEmpty {
+			t.Errorf("%s: Back expected '%v' got '%v'", name, ErrEmpty, err)
+		}
+	}
+}
+
+func TestPush_And_Pop_At_Both_Ends(t *testing.T) {
+	for name, newDeque := range implementations {
+		sut := newDeque()
+		sut.PushBack(2)
+		sut.PushFront(1)
+		sut.PushBack(3)
+		sut.PushFront(0)
+
+		if v, _ := sut.Front(); v != 0 {
+			t.Errorf("%s: Front expected '0' got '%d'", name, v)
+		}
+		if v, _ := sut.Back(); v != 3 {
+			t.Errorf("%s: Back expected '3' got '%d'", name, v)
+		}
+		if v, _ := sut.PopBack(); v != 3 {
+			t.Errorf("%s: PopBack expected '3' got '%d'", name, v)
+		}
+		if v, _ := sut.PopFront(); v != 0 {
+			t.Errorf("%s: PopFront expected '0' got '%d'", name, v)
+		}
+		if sut.Len() != 2 {
+			t.Errorf("%s: expected '2' got '%d'", name, sut.Len())
+		}
+	}
+}
+
+func TestDeque_Matches_Slice_Reference(t *testing.T) {
+	for name, newDeque := range implementations {
+		r := rand.New(rand.NewSource(1))
+		sut := newDeque()
+		reference := []int{}
+		for i := 0; i < 2000; i++ {
+			switch r.Intn(4) {
+			case 0:
+				sut.PushFront(i)
+				reference = slices.Insert(reference, 0, i)
+			case 1:
+				sut.PushBack(i)
+				reference = append(reference, i)
+			case 2:
+				v, err := sut.PopFront()
+				if len(reference) == 0 {
+					if err != ErrEmpty {
+						t.Fatalf("%s: expected '%v' got '%v'", name, ErrEmpty, err)
+					}
+					continue
+				}
+				if v != reference[0] {
+					t.Fatalf("%s: PopFront expected '%d' got '%d'", name, reference[0], v)
+				}
+				reference = reference[1:]
+			case 3:
+				v, err := sut.PopBack()
+				if len(reference) == 0 {
+					if err != ErrEmpty {
+						t.Fatalf("%s: expected '%v' got '%v'", name, ErrEmpty, err)
+					}
+					continue
+				}
+				if v != reference[len(reference)-1] {
+					t.Fatalf("%s: PopBack expected '%d' got '%d'", name, reference[len(reference)-1], v)
+				}
+				reference = reference[:len(reference)-1]
+			}
+			if sut.Len() != len(reference) {
+				t.Fatalf("%s: expected length '%d' got '%d'", name, len(reference), sut.Len())
+			}
+		}
+	}
+}
+
+func benchmarkPushPop(b *testing.B, sut dequer[int]) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			sut.PushBack(j)
+			sut.PushFront(j)
+		}
+		for j := 0; j < 100; j++ {
+			sut.PopFront()
+			sut.PopBack()
+		}
+	}
+}
+
+func BenchmarkDeque(b *testing.B) {
+	benchmarkPushPop(b, New[int]())
+}
+
+func BenchmarkSliceDeque(b *testing.B) {
+	benchmarkPushPop(b, NewSlice[int]())
+}
@@ -0,0 +1,132 @@
+package linkedlist
+
+import "iter"
+
+// CircularList is a ring of Nodes in which the last Node links back to the first, with a cursor marking the
+// current Node. It suits round-robin scheduling: Step moves the cursor along the ring and RemoveCurrent takes
+// the current Node out, leaving the cursor on the Node which followed it
+type CircularList[T any] struct {
+	current *Node[T]
+	prev    *Node[T] // the Node whose Next is current, kept so the current Node can be unlinked in constant time
+	length  int
+}
+
+// NewCircular constructs a CircularList holding the supplied values, with the cursor on the first of them
+func NewCircular[T any](values ...T) *CircularList[T] {
+	l := &CircularList[T]{}
+	for _, v := range values {
+		l.Insert(v)
+	}
+	return l
+}
+
+// Len returns the number of Nodes in the CircularList
+func (l *CircularList[T]) Len() int {
+	return l.length
+}
+
+// Insert adds the supplied data in a Node just before the cursor, making it the last Node reached when stepping
+// around the ring from the current Node
+func (l *CircularList[T]) Insert(data T) {
+	newNode := NewNode(data)
+	l.length++
+	if l.current == nil {
+		newNode.Next = newNode
+		l.current = newNode
+		l.prev = newNode
+		return
+	}
+	newNode.Next = l.current
+	l.prev.Next = newNode
+	l.prev = newNode
+}
+
+// Current returns the data of the Node at the cursor and true, or the zero value and false when the
+// CircularList is empty
+func (l *CircularList[T]) Current() (T, bool) {
+	if l.current == nil {
+		var zero T
+		return zero, false
+	}
+	return l.current.Data, true
+}
+
+// Step moves the cursor to the following Node and returns its' data, as Current does
+func (l *CircularList[T]) Step() (T, bool) {
+	if l.current != nil {
+		l.prev = l.current
+		l.current = l.current.Next
+	}
+	return l.Current()
+}
+
+// Rotate moves the cursor 'n' Nodes forward around the ring, or backward when 'n' is negative. Moving backward
+// is done by moving forward the complementary distance, as the Nodes only link forward
+func (l *CircularList[T]) Rotate(n int) {
+	if l.length == 0 {
+		return
+	}
+	n %= l.length
+	if n < 0 {
+		n += l.length
+	}
+	for i := 0; i < n; i++ {
+		l.prev = l.current
+		l.current = l.current.Next
+	}
+}
+
+// RemoveCurrent unlinks the Node at the cursor and returns its' data, moving the cursor to the following Node.
+// It returns the zero value and false when the CircularList is empty
+func (l *CircularList[T]) RemoveCurrent() (T, bool) {
+	if l.current == nil {
+		var zero T
+		return zero, false
+	}
+	removed := l.current
+	l.length--
+	if l.length == 0 {
+		l.current = nil
+		l.prev = nil
+	} else {
+		l.prev.Next = removed.Next
+		l.current = removed.Next
+	}
+	removed.Next = nil
+	return removed.Data, true
+}
+
+// Josephus counts 'k' Nodes around the ring starting from the cursor, removing the Node the count ends on and
+// restarting the count from the Node after it, until the CircularList is empty. It returns the data in the order
+// it was removed, the last value being the survivor of the Josephus problem. 'k' less than 1 is treated as 1
+func (l *CircularList[T]) Josephus(k int) []T {
+	if k < 1 {
+		k = 1
+	}
+	removed := make([]T, 0, l.length)
+	for l.length > 0 {
+		l.Rotate(k - 1)
+		data, _ := l.RemoveCurrent()
+		removed = append(removed, data)
+	}
+	return removed
+}
+
+// All returns an iterator over the data of each Node once around the ring, starting at the cursor
+func (l *CircularList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if l.current == nil {
+			return
+		}
+		n := l.current
+		for {
+			if !yield(n.Data) {
+				return
+			}
+			n = n.Next
+			if n == l.current {
+				return
+			}
+		}
+	}
+}
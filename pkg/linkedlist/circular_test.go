@@ -0,0 +1,99 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func Test_Circular_Empty(t *testing.T) {
+	sut := NewCircular[string]()
+	if _, ok := sut.Current(); ok {
+		t.Errorf("expected Current of empty list to be 'false'")
+	}
+	if _, ok := sut.Step(); ok {
+		t.Errorf("expected Step of empty list to be 'false'")
+	}
+	if _, ok := sut.RemoveCurrent(); ok {
+		t.Errorf("expected RemoveCurrent of empty list to be 'false'")
+	}
+	sut.Rotate(3)
+	if actual := slices.Collect(sut.All()); len(actual) != 0 {
+		t.Errorf("expected no values got '%v'", actual)
+	}
+}
+
+func Test_Circular_Insert_Places_Before_Cursor(t *testing.T) {
+	sut := NewCircular("Testing", "One")
+	sut.Insert("Two")
+
+	expected := []string{"Testing", "One", "Two"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 3 {
+		t.Errorf("expected '3' got '%d'", sut.Len())
+	}
+}
+
+func Test_Circular_Step_Wraps_Around(t *testing.T) {
+	sut := NewCircular("Testing", "One", "Two")
+
+	actual := []string{}
+	for i := 0; i < 4; i++ {
+		v, _ := sut.Step()
+		actual = append(actual, v)
+	}
+	expected := []string{"One", "Two", "Testing", "One"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func Test_Circular_Rotate(t *testing.T) {
+	cases := []struct {
+		n        int
+		expected string
+	}{{0, "a"}, {2, "c"}, {5, "b"}, {-1, "d"}, {-6, "c"}}
+	for _, c := range cases {
+		sut := NewCircular("a", "b", "c", "d")
+		sut.Rotate(c.n)
+		if actual, _ := sut.Current(); actual != c.expected {
+			t.Errorf("Rotate(%d) expected '%s' got '%s'", c.n, c.expected, actual)
+		}
+	}
+}
+
+func Test_Circular_RemoveCurrent_Moves_To_Next(t *testing.T) {
+	sut := NewCircular("Testing", "One", "Two")
+	sut.Step()
+
+	if v, _ := sut.RemoveCurrent(); v != "One" {
+		t.Errorf("expected 'One' got '%s'", v)
+	}
+	expected := []string{"Two", "Testing"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	sut.RemoveCurrent()
+	sut.RemoveCurrent()
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+	sut.Insert("Three")
+	if v, _ := sut.Current(); v != "Three" {
+		t.Errorf("expected 'Three' got '%s'", v)
+	}
+}
+
+func Test_Circular_Josephus(t *testing.T) {
+	sut := NewCircular(1, 2, 3, 4, 5, 6, 7)
+
+	expected := []int{3, 6, 2, 7, 5, 1, 4}
+	if actual := sut.Josephus(3); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+}
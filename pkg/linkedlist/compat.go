@@ -0,0 +1,20 @@
+package linkedlist
+
+// The aliases and constructor below preserve the interface{} based API that predates LinkedList[T]. Callers
+// that spelled out linkedlist.LinkedList, linkedlist.Node or linkedlist.LinkableList switch to the Any* names;
+// calls to New and NewNode keep compiling unchanged.
+type (
+	// AnyList is a LinkedList holding interface{} values
+	AnyList = LinkedList[any]
+
+	// AnyNode is a Node holding an interface{} value
+	AnyNode = Node[any]
+
+	// AnyLinkableList is a LinkableList holding interface{} values
+	AnyLinkableList = LinkableList[any]
+)
+
+// New constructs an instance of LinkedList holding interface{} values
+func New() LinkableList[any] {
+	return &LinkedList[any]{}
+}
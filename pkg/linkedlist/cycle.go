@@ -0,0 +1,71 @@
+package linkedlist
+
+// HasCycle reports whether following the Next references from the Head of the LinkedList loops back to a Node
+// already visited, using Floyd's tortoise and hare algorithm
+func (l *LinkedList[T]) HasCycle() bool {
+	return meetingNode(l.Head) != nil
+}
+
+// CycleStart returns the first Node of the cycle, i.e. the first Node reached twice when following Next from the
+// Head, or nil when the LinkedList has no cycle
+func (l *LinkedList[T]) CycleStart() *Node[T] {
+	meeting := meetingNode(l.Head)
+	if meeting == nil {
+		return nil
+	}
+	// the distance from the Head to the cycle start equals the distance from the meeting Node to the cycle start
+	// moving forward around the cycle, so two pointers advancing one Node at a time meet at the start
+	start := l.Head
+	for start != meeting {
+		start = start.Next
+		meeting = meeting.Next
+	}
+	return start
+}
+
+// CycleLength returns the number of Nodes in the cycle, or 0 when the LinkedList has no cycle
+func (l *LinkedList[T]) CycleLength() int {
+	meeting := meetingNode(l.Head)
+	if meeting == nil {
+		return 0
+	}
+	length := 1
+	for n := meeting.Next; n != meeting; n = n.Next {
+		length++
+	}
+	return length
+}
+
+// BreakCycle unlinks the Next reference which closes the cycle, making the LinkedList linear again, and reports
+// whether there was a cycle to break. The length of the LinkedList is recounted afterwards
+func (l *LinkedList[T]) BreakCycle() bool {
+	start := l.CycleStart()
+	if start == nil {
+		return false
+	}
+	last := start
+	for last.Next != start {
+		last = last.Next
+	}
+	last.Next = nil
+
+	l.length = 0
+	for n := l.Head; n != nil; n = n.Next {
+		l.length++
+	}
+	return true
+}
+
+// meetingNode returns the Node at which a pointer advancing one Node at a time and a pointer advancing two Nodes
+// at a time meet, or nil when the chain of Nodes starting at 'head' ends without a cycle
+func meetingNode[T any](head *Node[T]) *Node[T] {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return slow
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// cyclicList builds a LinkedList of the values 0 to n-1 whose last Node links back to the Node at index 'start'
+func cyclicList(n, start int) (*LinkedList[int], *Node[int]) {
+	l := &LinkedList[int]{}
+	for i := 0; i < n; i++ {
+		l.InsertLast(i)
+	}
+	last, _ := l.GetLastNode()
+	target := l.Head
+	for i := 0; i < start; i++ {
+		target = target.Next
+	}
+	last.Next = target
+	return l, target
+}
+
+func Test_HasCycle_Returns_False_For_Linear_List(t *testing.T) {
+	sut := LinkedList[int]{}
+	if sut.HasCycle() {
+		t.Errorf("expected 'false' for empty list")
+	}
+	sut.InsertLast(1)
+	sut.InsertLast(2)
+	if sut.HasCycle() || sut.CycleStart() != nil || sut.CycleLength() != 0 {
+		t.Errorf("expected no cycle got start '%+v' length '%d'", sut.CycleStart(), sut.CycleLength())
+	}
+	if sut.BreakCycle() {
+		t.Errorf("expected BreakCycle to be 'false'")
+	}
+}
+
+func Test_HasCycle_CycleStart_CycleLength(t *testing.T) {
+	cases := []struct{ n, start int }{{6, 2}, {6, 0}, {6, 5}, {1, 0}}
+	for _, c := range cases {
+		sut, expectedStart := cyclicList(c.n, c.start)
+		if !sut.HasCycle() {
+			t.Errorf("%+v: expected HasCycle to be 'true'", c)
+		}
+		if actual := sut.CycleStart(); actual != expectedStart {
+			t.Errorf("%+v: expected start '%d' got '%+v'", c, expectedStart.Data, actual)
+		}
+		if actual := sut.CycleLength(); actual != c.n-c.start {
+			t.Errorf("%+v: expected length '%d' got '%d'", c, c.n-c.start, actual)
+		}
+	}
+}
+
+func Test_Cycle_Returns_ErrCycleDetected_Instead_Of_Hanging(t *testing.T) {
+	sut, _ := cyclicList(5, 1)
+
+	if _, err := sut.GetLastNode(); err != ErrCycleDetected {
+		t.Errorf("GetLastNode expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+	if err := sut.InsertLast(9); err != ErrCycleDetected {
+		t.Errorf("InsertLast expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+	if err := sut.Reverse(); err != ErrCycleDetected {
+		t.Errorf("Reverse expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+	if err := sut.DeleteNodeByKey(3); err != ErrCycleDetected {
+		t.Errorf("DeleteNodeByKey expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+	if sut.Len() != 5 {
+		t.Errorf("expected '5' got '%d'", sut.Len())
+	}
+}
+
+func Test_BreakCycle_Restores_Linear_List(t *testing.T) {
+	sut, _ := cyclicList(5, 2)
+
+	if !sut.BreakCycle() {
+		t.Errorf("expected BreakCycle to be 'true'")
+	}
+	if sut.HasCycle() {
+		t.Errorf("expected HasCycle to be 'false' after BreakCycle")
+	}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual([]int{0, 1, 2, 3, 4}, actual) {
+		t.Errorf("expected '%v' got '%v'", []int{0, 1, 2, 3, 4}, actual)
+	}
+	if last, err := sut.GetLastNode(); err != nil || last.Data != 4 {
+		t.Errorf("expected '4' got '%+v' (err: %v)", last, err)
+	}
+}
+
+func Test_BreakCycle_Recounts_Length(t *testing.T) {
+	sut, _ := cyclicList(3, 0)
+	// splice a Node in by hand so the tracked length is stale
+	sut.Head.Next = &Node[int]{Data: 7, Next: sut.Head.Next}
+
+	sut.BreakCycle()
+
+	if sut.Len() != 4 {
+		t.Errorf("expected '4' got '%d'", sut.Len())
+	}
+}
+
+func Test_GetLastNode_Returns_Nil_For_Empty_List(t *testing.T) {
+	sut := LinkedList[int]{}
+	if last, err := sut.GetLastNode(); last != nil || err != nil {
+		t.Errorf("expected '<nil>' got '%+v' (err: %v)", last, err)
+	}
+}
+
+func Test_Doubly_Cycle_Returns_ErrCycleDetected(t *testing.T) {
+	sut := NewDoubly[int]()
+	sut.InsertLast(0)
+	sut.InsertLast(1)
+	sut.Tail.Next = sut.Head
+
+	if err := sut.Reverse(); err != ErrCycleDetected {
+		t.Errorf("Reverse expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+	if err := sut.DeleteNodeByKey(1); err != ErrCycleDetected {
+		t.Errorf("DeleteNodeByKey expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+}
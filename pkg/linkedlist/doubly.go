@@ -0,0 +1,173 @@
+package linkedlist
+
+// DoublyLinkedList is a linear data structure storing data and the addresses "next" and "prev" at each node. It
+// keeps references to both its' Head and Tail so inserting and removing at either end, or next to a known Node,
+// takes constant time
+type DoublyLinkedList[T any] struct {
+	Head   *Node[T]
+	Tail   *Node[T]
+	equal  func(a, b T) bool
+	length int
+}
+
+// NewDoubly constructs an instance of DoublyLinkedList holding values of the comparable type T
+func NewDoubly[T comparable]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{}
+}
+
+// NewDoublyWithEqual constructs an instance of DoublyLinkedList which uses the supplied 'equal' function to
+// match keys in DeleteNodeByKey
+func NewDoublyWithEqual[T any](equal func(a, b T) bool) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{equal: equal}
+}
+
+// InsertFront inserts the supplied data in a Node at the front of the DoublyLinkedList
+func (l *DoublyLinkedList[T]) InsertFront(data T) {
+	if l.Head == nil {
+		l.pushEmpty(data)
+		return
+	}
+	l.InsertBefore(l.Head, data)
+}
+
+// InsertLast inserts the supplied data in a Node at the last position in the DoublyLinkedList. The Tail is
+// always known so the error is always nil
+func (l *DoublyLinkedList[T]) InsertLast(data T) error {
+	if l.Tail == nil {
+		l.pushEmpty(data)
+		return nil
+	}
+	l.InsertAfter(l.Tail, data)
+	return nil
+}
+
+// GetLastNode returns the Tail of the DoublyLinkedList. The error is always nil
+func (l *DoublyLinkedList[T]) GetLastNode() (*Node[T], error) {
+	return l.Tail, nil
+}
+
+// InsertBefore inserts data in the Node before the supplied nextNode, which must belong to the DoublyLinkedList
+func (l *DoublyLinkedList[T]) InsertBefore(nextNode *Node[T], data T) {
+	if nextNode == nil {
+		return
+	}
+	newNode := NewNode(data)
+	newNode.Prev = nextNode.Prev
+	newNode.Next = nextNode
+	if nextNode.Prev == nil {
+		l.Head = newNode
+	} else {
+		nextNode.Prev.Next = newNode
+	}
+	nextNode.Prev = newNode
+	l.length++
+}
+
+// InsertAfter inserts data in the Node after the supplied prevNode, which must belong to the DoublyLinkedList
+func (l *DoublyLinkedList[T]) InsertAfter(prevNode *Node[T], data T) {
+	if prevNode == nil {
+		return
+	}
+	newNode := NewNode(data)
+	newNode.Prev = prevNode
+	newNode.Next = prevNode.Next
+	if prevNode.Next == nil {
+		l.Tail = newNode
+	} else {
+		prevNode.Next.Prev = newNode
+	}
+	prevNode.Next = newNode
+	l.length++
+}
+
+// Remove unlinks the supplied node, which must belong to the DoublyLinkedList. A node which is already unlinked,
+// having neither a Prev nor a Next while not being the Head or the Tail, is ignored
+func (l *DoublyLinkedList[T]) Remove(node *Node[T]) {
+	l.unlink(node)
+}
+
+// MoveToFront relinks the supplied node, which must belong to the DoublyLinkedList, as its' Head
+func (l *DoublyLinkedList[T]) MoveToFront(node *Node[T]) {
+	if node == nil || node == l.Head || !l.unlink(node) {
+		return
+	}
+	l.length++
+	node.Next = l.Head
+	l.Head.Prev = node
+	l.Head = node
+}
+
+// MoveToBack relinks the supplied node, which must belong to the DoublyLinkedList, as its' Tail
+func (l *DoublyLinkedList[T]) MoveToBack(node *Node[T]) {
+	if node == nil || node == l.Tail || !l.unlink(node) {
+		return
+	}
+	l.length++
+	node.Prev = l.Tail
+	l.Tail.Next = node
+	l.Tail = node
+}
+
+// DeleteNodeByKey deletes the first node in the DoublyLinkedList having its' Data equal the supplied 'key', or
+// returns ErrCycleDetected when the Nodes form a cycle
+func (l *DoublyLinkedList[T]) DeleteNodeByKey(key T) error {
+	if meetingNode(l.Head) != nil {
+		return ErrCycleDetected
+	}
+	for temp := l.Head; temp != nil; temp = temp.Next {
+		if matches(l.equal, temp.Data, key) {
+			l.Remove(temp)
+			return nil
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the order of the Nodes in a DoublyLinkedList instance, or returns ErrCycleDetected when the
+// Nodes form a cycle
+func (l *DoublyLinkedList[T]) Reverse() error {
+	if meetingNode(l.Head) != nil {
+		return ErrCycleDetected
+	}
+	current := l.Head
+	for current != nil {
+		current.Next, current.Prev = current.Prev, current.Next
+		current = current.Prev
+	}
+	l.Head, l.Tail = l.Tail, l.Head
+	return nil
+}
+
+// Len returns the number of Nodes in the DoublyLinkedList
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.length
+}
+
+func (l *DoublyLinkedList[T]) pushEmpty(data T) {
+	newNode := NewNode(data)
+	l.Head = newNode
+	l.Tail = newNode
+	l.length = 1
+}
+
+// unlink removes the supplied node from the chain, reporting whether it was linked. A node without a Prev must be
+// the Head and one without a Next must be the Tail, otherwise it was already removed or never belonged to the list
+func (l *DoublyLinkedList[T]) unlink(node *Node[T]) bool {
+	if node == nil || (node.Prev == nil && node != l.Head) || (node.Next == nil && node != l.Tail) {
+		return false
+	}
+	if node.Prev == nil {
+		l.Head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+	if node.Next == nil {
+		l.Tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+	node.Next = nil
+	node.Prev = nil
+	l.length--
+	return true
+}
@@ -0,0 +1,195 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_DoublyLinkedList_Satisfies_LinkableList(t *testing.T) {
+	var sut LinkableList[string] = NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertFront("One")
+
+	last, err := sut.GetLastNode()
+	if err != nil || last.Data != "Testing" {
+		t.Errorf("expected 'Testing' got '%+v' (err: %v)", last, err)
+	}
+}
+
+func Test_Doubly_InsertFront_Makes_New_Head_Node(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertFront("Testing")
+	sut.InsertFront("One")
+	sut.InsertFront("Two")
+
+	assertDoubly(t, sut, "Two", "One", "Testing")
+}
+
+func Test_Doubly_InsertLast_Makes_New_Tail_Node(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	assertDoubly(t, sut, "Testing", "One", "Two")
+	if last, _ := sut.GetLastNode(); last != sut.Tail {
+		t.Errorf("expected '%+v' got '%+v'", sut.Tail, last)
+	}
+}
+
+func Test_Doubly_InsertBefore(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("Two")
+
+	sut.InsertBefore(sut.Tail, "One")
+	sut.InsertBefore(sut.Head, "Zero")
+	sut.InsertBefore(nil, "Ignored")
+
+	assertDoubly(t, sut, "Zero", "Testing", "One", "Two")
+}
+
+func Test_Doubly_InsertAfter(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("Two")
+
+	sut.InsertAfter(sut.Head, "One")
+	sut.InsertAfter(sut.Tail, "Three")
+	sut.InsertAfter(nil, "Ignored")
+
+	assertDoubly(t, sut, "Testing", "One", "Two", "Three")
+}
+
+func Test_Doubly_Remove(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+	sut.InsertLast("Three")
+
+	sut.Remove(sut.Head.Next)
+	assertDoubly(t, sut, "Testing", "Two", "Three")
+
+	sut.Remove(sut.Head)
+	assertDoubly(t, sut, "Two", "Three")
+
+	sut.Remove(sut.Tail)
+	assertDoubly(t, sut, "Two")
+
+	sut.Remove(sut.Tail)
+	assertDoubly(t, sut)
+	if sut.Head != nil || sut.Tail != nil {
+		t.Errorf("expected '<nil>' Head and Tail got '%+v' and '%+v'", sut.Head, sut.Tail)
+	}
+}
+
+func Test_Doubly_Remove_Same_Node_Twice_Is_Ignored(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+	middle := sut.Head.Next
+
+	sut.Remove(middle)
+	sut.Remove(middle)
+	assertDoubly(t, sut, "Testing", "Two")
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+
+	sut.MoveToFront(middle)
+	sut.MoveToBack(NewNode("Foreign"))
+	assertDoubly(t, sut, "Testing", "Two")
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+func Test_Doubly_MoveToFront_And_MoveToBack(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+	middle := sut.Head.Next
+
+	sut.MoveToFront(middle)
+	assertDoubly(t, sut, "One", "Testing", "Two")
+	sut.MoveToFront(sut.Head)
+	assertDoubly(t, sut, "One", "Testing", "Two")
+
+	sut.MoveToBack(middle)
+	assertDoubly(t, sut, "Testing", "Two", "One")
+	sut.MoveToBack(sut.Tail)
+	assertDoubly(t, sut, "Testing", "Two", "One")
+
+	if sut.Len() != 3 {
+		t.Errorf("expected '3' got '%d'", sut.Len())
+	}
+}
+
+func Test_Doubly_DeleteNodeByKey(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	sut.DeleteNodeByKey("Invalid")
+	assertDoubly(t, sut, "Testing", "One", "Two")
+
+	sut.DeleteNodeByKey("Two")
+	assertDoubly(t, sut, "Testing", "One")
+
+	sut.DeleteNodeByKey("Testing")
+	assertDoubly(t, sut, "One")
+}
+
+func Test_Doubly_Reverse(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	sut.Reverse()
+
+	assertDoubly(t, sut, "Two", "One", "Testing")
+}
+
+func Test_Doubly_Len_Is_Maintained_Across_Operations(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertFront("One")
+	sut.InsertLast("Three")
+	sut.InsertAfter(sut.Head, "Two")
+	sut.InsertBefore(sut.Head, "Testing")
+	if sut.Len() != 4 {
+		t.Errorf("expected '4' got '%d'", sut.Len())
+	}
+	sut.Remove(sut.Tail)
+	sut.DeleteNodeByKey("Testing")
+	sut.DeleteNodeByKey("Invalid")
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+// assertDoubly walks the list from Head to Tail and back again, comparing the Data of each node to 'expected'
+func assertDoubly(t *testing.T, l *DoublyLinkedList[string], expected ...string) {
+	t.Helper()
+	forward := []string{}
+	for n := l.Head; n != nil; n = n.Next {
+		forward = append(forward, n.Data)
+	}
+	backward := []string{}
+	for n := l.Tail; n != nil; n = n.Prev {
+		backward = append([]string{n.Data}, backward...)
+	}
+	if expected == nil {
+		expected = []string{}
+	}
+	if !reflect.DeepEqual(expected, forward) {
+		t.Errorf("expected forward '%v' got '%v'", expected, forward)
+	}
+	if !reflect.DeepEqual(expected, backward) {
+		t.Errorf("expected backward '%v' got '%v'", expected, backward)
+	}
+}
@@ -0,0 +1,92 @@
+package linkedlist
+
+// Map returns a new LinkedList holding the result of calling 'f' on the data of each Node of 'l', in order
+func Map[T, U any](l *LinkedList[T], f func(T) U) *LinkedList[U] {
+	result := &LinkedList[U]{}
+	var tail *Node[U]
+	for n := l.Head; n != nil; n = n.Next {
+		tail = result.appendAfter(tail, f(n.Data))
+	}
+	return result
+}
+
+// Filter returns a new LinkedList holding the data of each Node of 'l' for which 'keep' returns true, in order
+func Filter[T any](l *LinkedList[T], keep func(T) bool) *LinkedList[T] {
+	result := &LinkedList[T]{equal: l.equal}
+	var tail *Node[T]
+	for n := l.Head; n != nil; n = n.Next {
+		if keep(n.Data) {
+			tail = result.appendAfter(tail, n.Data)
+		}
+	}
+	return result
+}
+
+// Reduce folds the data of each Node of 'l' into an accumulator, starting from 'initial'
+func Reduce[T, A any](l *LinkedList[T], initial A, f func(acc A, data T) A) A {
+	acc := initial
+	for n := l.Head; n != nil; n = n.Next {
+		acc = f(acc, n.Data)
+	}
+	return acc
+}
+
+// Find returns the data of the first Node of 'l' satisfying 'match' along with true, or the zero value and false
+// when there is no such Node
+func Find[T any](l *LinkedList[T], match func(T) bool) (T, bool) {
+	for n := l.Head; n != nil; n = n.Next {
+		if match(n.Data) {
+			return n.Data, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Any reports whether the data of at least one Node of 'l' satisfies 'match'
+func Any[T any](l *LinkedList[T], match func(T) bool) bool {
+	_, found := Find(l, match)
+	return found
+}
+
+// All reports whether the data of every Node of 'l' satisfies 'match'. It is true for an empty LinkedList
+func All[T any](l *LinkedList[T], match func(T) bool) bool {
+	return !Any(l, func(data T) bool { return !match(data) })
+}
+
+// Count returns the number of Nodes of 'l' whose data satisfies 'match'
+func Count[T any](l *LinkedList[T], match func(T) bool) int {
+	return Reduce(l, 0, func(count int, data T) int {
+		if match(data) {
+			count++
+		}
+		return count
+	})
+}
+
+// Partition returns two new LinkedLists, the first holding the data of each Node of 'l' satisfying 'match' and
+// the second holding the rest, both in order
+func Partition[T any](l *LinkedList[T], match func(T) bool) (matched, rest *LinkedList[T]) {
+	matched = &LinkedList[T]{equal: l.equal}
+	rest = &LinkedList[T]{equal: l.equal}
+	var matchedTail, restTail *Node[T]
+	for n := l.Head; n != nil; n = n.Next {
+		if match(n.Data) {
+			matchedTail = matched.appendAfter(matchedTail, n.Data)
+		} else {
+			restTail = rest.appendAfter(restTail, n.Data)
+		}
+	}
+	return matched, rest
+}
+
+// appendAfter links a new Node holding 'data' after 'tail', or as the Head when 'tail' is nil, returning the new
+// Node so lists can be built front to back without walking to the last Node each time
+func (l *LinkedList[T]) appendAfter(tail *Node[T], data T) *Node[T] {
+	if tail == nil {
+		l.InsertFront(data)
+		return l.Head
+	}
+	l.InsertAfter(tail, data)
+	return tail.Next
+}
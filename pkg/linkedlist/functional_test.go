@@ -0,0 +1,122 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func Test_Map_Returns_Transformed_List(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+
+	actual := Map(&sut, func(s string) int { return len(s) })
+
+	if !reflect.DeepEqual([]int{7, 3}, slices.Collect(actual.All())) {
+		t.Errorf("expected '%v' got '%v'", []int{7, 3}, slices.Collect(actual.All()))
+	}
+	if actual.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", actual.Len())
+	}
+	assertUnchanged(t, &sut, "Testing", "One")
+}
+
+func Test_Filter_Returns_Matching_Nodes(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	actual := Filter(&sut, func(s string) bool { return strings.HasPrefix(s, "T") })
+
+	if !reflect.DeepEqual([]string{"Testing", "Two"}, slices.Collect(actual.All())) {
+		t.Errorf("expected '%v' got '%v'", []string{"Testing", "Two"}, slices.Collect(actual.All()))
+	}
+	assertUnchanged(t, &sut, "Testing", "One", "Two")
+}
+
+func Test_Reduce_Folds_Data(t *testing.T) {
+	sut := LinkedList[int]{}
+	sut.InsertLast(1)
+	sut.InsertLast(2)
+	sut.InsertLast(3)
+
+	actual := Reduce(&sut, 10, func(acc, data int) int { return acc + data })
+	if actual != 16 {
+		t.Errorf("expected '16' got '%d'", actual)
+	}
+}
+
+func Test_Find_Returns_First_Match(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	actual, found := Find(&sut, func(s string) bool { return len(s) == 3 })
+	if !found || actual != "One" {
+		t.Errorf("expected 'One' got '%s' (found: %v)", actual, found)
+	}
+	actual, found = Find(&sut, func(s string) bool { return s == "Invalid" })
+	if found || actual != "" {
+		t.Errorf("expected '' got '%s' (found: %v)", actual, found)
+	}
+}
+
+func Test_Any_All_Count(t *testing.T) {
+	sut := LinkedList[int]{}
+	sut.InsertLast(1)
+	sut.InsertLast(2)
+	sut.InsertLast(3)
+
+	even := func(i int) bool { return i%2 == 0 }
+	positive := func(i int) bool { return i > 0 }
+
+	if !Any(&sut, even) {
+		t.Errorf("expected Any to be 'true'")
+	}
+	if All(&sut, even) {
+		t.Errorf("expected All to be 'false'")
+	}
+	if !All(&sut, positive) {
+		t.Errorf("expected All to be 'true'")
+	}
+	if !All(&LinkedList[int]{}, even) {
+		t.Errorf("expected All of an empty list to be 'true'")
+	}
+	if Count(&sut, even) != 1 {
+		t.Errorf("expected '1' got '%d'", Count(&sut, even))
+	}
+}
+
+func Test_Partition_Splits_List(t *testing.T) {
+	sut := LinkedList[int]{}
+	for i := 1; i <= 5; i++ {
+		sut.InsertLast(i)
+	}
+
+	matched, rest := Partition(&sut, func(i int) bool { return i%2 == 0 })
+
+	if !reflect.DeepEqual([]int{2, 4}, slices.Collect(matched.All())) {
+		t.Errorf("expected '%v' got '%v'", []int{2, 4}, slices.Collect(matched.All()))
+	}
+	if !reflect.DeepEqual([]int{1, 3, 5}, slices.Collect(rest.All())) {
+		t.Errorf("expected '%v' got '%v'", []int{1, 3, 5}, slices.Collect(rest.All()))
+	}
+	if matched.Len() != 2 || rest.Len() != 3 {
+		t.Errorf("expected lengths '2' and '3' got '%d' and '%d'", matched.Len(), rest.Len())
+	}
+	if sut.Len() != 5 {
+		t.Errorf("expected source to be unchanged got length '%d'", sut.Len())
+	}
+}
+
+// assertUnchanged compares the Data of each node of 'l' to 'expected'
+func assertUnchanged(t *testing.T, l *LinkedList[string], expected ...string) {
+	t.Helper()
+	if actual := slices.Collect(l.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected source to be unchanged '%v' got '%v'", expected, actual)
+	}
+}
@@ -0,0 +1,131 @@
+package linkedlist
+
+import "iter"
+
+// All returns an iterator over the data of each Node from the Head to the last Node of the LinkedList
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.Head; n != nil; n = n.Next {
+			if !yield(n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the data of each Node from the last Node to the Head of the LinkedList. The
+// Nodes only link forward, so they are gathered into a slice before the first value is yielded
+func (l *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nodes := make([]*Node[T], 0, max(l.length, 0))
+		for n := l.Head; n != nil; n = n.Next {
+			nodes = append(nodes, n)
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].Data) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed returns an iterator over the index and data of each Node from the Head to the last Node of the
+// LinkedList
+func (l *LinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for n := l.Head; n != nil; n = n.Next {
+			if !yield(i, n.Data) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// All returns an iterator over the data of each Node from the Head to the Tail of the DoublyLinkedList
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.Head; n != nil; n = n.Next {
+			if !yield(n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the data of each Node from the Tail to the Head of the DoublyLinkedList
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.Tail; n != nil; n = n.Prev {
+			if !yield(n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator walks the Nodes of a LinkedList one at a time and allows the current Node to be removed without
+// disturbing the traversal. Call Next before reading the first Value, e.g.
+//
+//	for it := l.Iterator(); it.Next(); {
+//		if it.Value() == key {
+//			it.Remove()
+//		}
+//	}
+type Iterator[T any] struct {
+	list    *LinkedList[T]
+	prev    *Node[T]
+	current *Node[T]
+	started bool
+	removed bool
+}
+
+// Iterator constructs an Iterator positioned before the Head of the LinkedList
+func (l *LinkedList[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{list: l}
+}
+
+// Next advances the Iterator to the following Node, returning false once the end of the LinkedList is reached
+func (it *Iterator[T]) Next() bool {
+	switch {
+	case !it.started:
+		it.started = true
+		it.current = it.list.Head
+	case it.removed:
+		it.removed = false
+		if it.prev == nil {
+			it.current = it.list.Head
+		} else {
+			it.current = it.prev.Next
+		}
+	case it.current != nil:
+		it.prev = it.current
+		it.current = it.current.Next
+	}
+	return it.current != nil
+}
+
+// Value returns the data of the current Node, which remains readable after it has been removed
+func (it *Iterator[T]) Value() T {
+	if it.current == nil {
+		var zero T
+		return zero
+	}
+	return it.current.Data
+}
+
+// Remove unlinks the current Node from the LinkedList. It does nothing before the first call to Next, after the
+// end has been reached, or when the current Node was already removed
+func (it *Iterator[T]) Remove() {
+	if it.current == nil || it.removed {
+		return
+	}
+	if it.prev == nil {
+		it.list.Head = it.current.Next
+	} else {
+		it.prev.Next = it.current.Next
+	}
+	it.list.unlinked()
+	it.removed = true
+}
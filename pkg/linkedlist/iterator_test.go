@@ -0,0 +1,143 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func Test_All_Ranges_From_Head(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	expected := []string{"Testing", "One", "Two"}
+	actual := slices.Collect(sut.All())
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func Test_All_Stops_When_Range_Breaks(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	actual := []string{}
+	for v := range sut.All() {
+		if v == "One" {
+			break
+		}
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]string{"Testing"}, actual) {
+		t.Errorf("expected '%v' got '%v'", []string{"Testing"}, actual)
+	}
+}
+
+func Test_Backward_Ranges_From_Last_Node(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	expected := []string{"Two", "One", "Testing"}
+	actual := slices.Collect(sut.Backward())
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func Test_Backward_List_Linked_Through_Head_Does_Not_Panic(t *testing.T) {
+	n1, n2 := NewNode(1), NewNode(2)
+	n1.Next = n2
+	sut := &LinkedList[int]{Head: n1, length: -1} // a length left wrong by linking Nodes directly
+
+	if actual := slices.Collect(sut.Backward()); !reflect.DeepEqual([]int{2, 1}, actual) {
+		t.Errorf("expected '%v' got '%v'", []int{2, 1}, actual)
+	}
+}
+
+func Test_Indexed_Yields_Index_And_Data(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+
+	expected := map[int]string{0: "Testing", 1: "One"}
+	actual := map[int]string{}
+	for i, v := range sut.Indexed() {
+		actual[i] = v
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func Test_Doubly_All_And_Backward(t *testing.T) {
+	sut := NewDoubly[string]()
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	expected := []string{"Testing", "One", "Two"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	expected = []string{"Two", "One", "Testing"}
+	if actual := slices.Collect(sut.Backward()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func Test_Iterator_Visits_Every_Node(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+
+	actual := []string{}
+	for it := sut.Iterator(); it.Next(); {
+		actual = append(actual, it.Value())
+	}
+	if !reflect.DeepEqual([]string{"Testing", "One"}, actual) {
+		t.Errorf("expected '%v' got '%v'", []string{"Testing", "One"}, actual)
+	}
+}
+
+func Test_Iterator_Remove_During_Traversal(t *testing.T) {
+	sut := LinkedList[int]{}
+	for i := 0; i < 6; i++ {
+		sut.InsertLast(i)
+	}
+
+	visited := []int{}
+	for it := sut.Iterator(); it.Next(); {
+		visited = append(visited, it.Value())
+		if it.Value()%2 == 0 {
+			it.Remove()
+			it.Remove()
+		}
+	}
+
+	if !reflect.DeepEqual([]int{0, 1, 2, 3, 4, 5}, visited) {
+		t.Errorf("expected every node to be visited got '%v'", visited)
+	}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual([]int{1, 3, 5}, actual) {
+		t.Errorf("expected '%v' got '%v'", []int{1, 3, 5}, actual)
+	}
+	if sut.Len() != 3 {
+		t.Errorf("expected '3' got '%d'", sut.Len())
+	}
+}
+
+func Test_Iterator_Remove_Before_Next_Is_Ignored(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+
+	it := sut.Iterator()
+	it.Remove()
+	if sut.Head == nil || sut.Len() != 1 {
+		t.Errorf("expected list to be unchanged")
+	}
+}
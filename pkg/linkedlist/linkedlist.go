@@ -1,100 +1,162 @@
 package linkedlist
 
-// LinkableList describes the set of methods of a LinkedList
-type LinkableList interface {
-	InsertFront(data interface{})
-	InsertLast(data interface{})
-	InsertAfter(prevNode *Node, data interface{})
-	GetLastNode() *Node
-	DeleteNodeByKey(key interface{})
-	Reverse()
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrIndexOutOfRange is an error value for when an index does not address a Node of the LinkedList
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	// ErrCycleDetected is an error value for when the Next references of the Nodes loop back on themselves, so
+	// the LinkedList has no last Node
+	ErrCycleDetected = errors.New("cycle detected in linked list")
+)
+
+// LinkableList describes the set of methods of a LinkedList holding values of type T
+type LinkableList[T any] interface {
+	InsertFront(data T)
+	InsertLast(data T) error
+	InsertAfter(prevNode *Node[T], data T)
+	GetLastNode() (*Node[T], error)
+	DeleteNodeByKey(key T) error
+	Reverse() error
+	Len() int
 }
 
-// Node represents a node in a LinkedList data structure
-type Node struct {
-	Data interface{}
-	Next *Node
+// Node represents a node in a LinkedList data structure. Prev is only maintained by DoublyLinkedList and is
+// always nil for the nodes of a LinkedList
+type Node[T any] struct {
+	Data T
+	Next *Node[T]
+	Prev *Node[T]
 }
 
 // NewNode constructs an instance of linkedlist.Node with the supplied 'data'
-func NewNode(data interface{}) *Node {
-	return &Node{
+func NewNode[T any](data T) *Node[T] {
+	return &Node[T]{
 		Data: data,
 		Next: nil,
 	}
 }
 
 // LinkedList is a linear data structure storing data and address "next" at each node. Its' Head
-// reference (pointer to Node) links to each subsequent Node in the LinkedList
-type LinkedList struct {
-	Head *Node
+// reference (pointer to Node) links to each subsequent Node in the LinkedList. The length is only kept
+// accurate when Nodes are linked and unlinked through the LinkedList methods
+type LinkedList[T any] struct {
+	Head   *Node[T]
+	equal  func(a, b T) bool
+	length int
+}
+
+// NewOf constructs an instance of LinkedList holding values of the comparable type T
+func NewOf[T comparable]() LinkableList[T] {
+	return &LinkedList[T]{}
 }
 
-// New constructs an instance of LinkedList
-func New() LinkableList {
-	return &LinkedList{}
+// NewWithEqual constructs an instance of LinkedList which uses the supplied 'equal' function to match keys in
+// DeleteNodeByKey. Use it for element types such as slices or maps which cannot be compared with ==
+func NewWithEqual[T any](equal func(a, b T) bool) LinkableList[T] {
+	return &LinkedList[T]{equal: equal}
 }
 
 // InsertFront inserts the supplied data in a Node at the front of the LinkedList
-func (l *LinkedList) InsertFront(data interface{}) {
-	newNode := &Node{Data: data}
+func (l *LinkedList[T]) InsertFront(data T) {
+	newNode := &Node[T]{Data: data}
 	newNode.Next = l.Head
 	l.Head = newNode
+	l.length++
 }
 
-// InsertLast inserts the supplied data in a Node at the last position in the LinkedList
-func (l *LinkedList) InsertLast(data interface{}) {
-	newNode := &Node{Data: data}
+// InsertLast inserts the supplied data in a Node at the last position in the LinkedList, or returns
+// ErrCycleDetected when there is no last position
+func (l *LinkedList[T]) InsertLast(data T) error {
+	newNode := &Node[T]{Data: data}
 	if l.Head == nil {
 		l.Head = newNode
-		return
+		l.length++
+		return nil
+	}
+	lastNode, err := l.GetLastNode()
+	if err != nil {
+		return err
 	}
-	lastNode := l.GetLastNode()
 	lastNode.Next = newNode
+	l.length++
+	return nil
 }
 
-// GetLastNode iterates the LinkedList until it reaches a nil "next" pointer then returns that node
-func (l *LinkedList) GetLastNode() *Node {
-	temp := l.Head
-	for temp.Next != nil {
-		temp = temp.Next
+// GetLastNode iterates the LinkedList until it reaches a nil "next" pointer then returns that node. It returns
+// nil for an empty LinkedList and ErrCycleDetected when the Nodes form a cycle
+func (l *LinkedList[T]) GetLastNode() (*Node[T], error) {
+	if l.Head == nil {
+		return nil, nil
+	}
+	// the fast pointer finds the last node while the slow pointer trails behind, they only meet in a cycle
+	slow, fast := l.Head, l.Head
+	for {
+		if fast.Next == nil {
+			return fast, nil
+		}
+		if fast.Next.Next == nil {
+			return fast.Next, nil
+		}
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return nil, ErrCycleDetected
+		}
 	}
-	return temp
 }
 
 // InsertAfter inserts data in the Node after the supplied prevNode in the LinkedList
-func (l *LinkedList) InsertAfter(prevNode *Node, data interface{}) {
+func (l *LinkedList[T]) InsertAfter(prevNode *Node[T], data T) {
 	if prevNode == nil {
 		return
 	}
 	newNode := NewNode(data)
 	newNode.Next = prevNode.Next
 	prevNode.Next = newNode
+	l.length++
 }
 
-// DeleteNodeByKey deletes the node in the LinkedList having its' Data equal the supplied 'key'
-func (l *LinkedList) DeleteNodeByKey(key interface{}) {
+// DeleteNodeByKey deletes the node in the LinkedList having its' Data equal the supplied 'key'. Keys are
+// matched with the function given to NewWithEqual, or with == when the values are comparable. ErrCycleDetected
+// is returned, leaving the LinkedList unchanged, when the Nodes form a cycle
+func (l *LinkedList[T]) DeleteNodeByKey(key T) error {
+	if l.HasCycle() {
+		return ErrCycleDetected
+	}
 	temp := l.Head
-	var prev *Node
-	if temp != nil && temp.Data == key {
+	var prev *Node[T]
+	if temp != nil && matches(l.equal, temp.Data, key) {
 		l.Head = temp.Next
-		return
+		l.unlinked()
+		return nil
 	}
-	for temp != nil && temp.Data != key {
+	for temp != nil && !matches(l.equal, temp.Data, key) {
 		prev = temp
 		temp = temp.Next
 	}
 	if temp == nil {
-		return
+		return nil
 	}
 	prev.Next = temp.Next
+	l.unlinked()
+	return nil
 }
 
-// Reverse reverses the order of the Nodes in a LinkedList instance
-func (l *LinkedList) Reverse() {
-	var prev *Node
+// Reverse reverses the order of the Nodes in a LinkedList instance, or returns ErrCycleDetected leaving it
+// unchanged when the Nodes form a cycle
+func (l *LinkedList[T]) Reverse() error {
+	if l.HasCycle() {
+		return ErrCycleDetected
+	}
+	var prev *Node[T]
 	current := l.Head
-	var temp *Node
+	var temp *Node[T]
 	for current != nil {
 		temp = current.Next
 		current.Next = prev
@@ -102,4 +164,110 @@ func (l *LinkedList) Reverse() {
 		current = temp
 	}
 	l.Head = prev
+	return nil
+}
+
+// Len returns the number of Nodes in the LinkedList
+func (l *LinkedList[T]) Len() int {
+	return l.length
+}
+
+// At returns the data held by the Node at index 'i', or ErrIndexOutOfRange when there is no such Node
+func (l *LinkedList[T]) At(i int) (T, error) {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Data, nil
+}
+
+// Set replaces the data held by the Node at index 'i', or returns ErrIndexOutOfRange when there is no such Node
+func (l *LinkedList[T]) Set(i int, data T) error {
+	node, err := l.nodeAt(i)
+	if err != nil {
+		return err
+	}
+	node.Data = data
+	return nil
+}
+
+// InsertAt inserts data in a Node at index 'i' so that it is preceded by 'i' Nodes. An index equal to Len
+// appends to the LinkedList, anything past it returns ErrIndexOutOfRange
+func (l *LinkedList[T]) InsertAt(i int, data T) error {
+	if i == 0 {
+		l.InsertFront(data)
+		return nil
+	}
+	prev, err := l.nodeAt(i - 1)
+	if err != nil {
+		return errors.Wrapf(ErrIndexOutOfRange, "insert at %d with length %d", i, l.length)
+	}
+	l.InsertAfter(prev, data)
+	return nil
+}
+
+// RemoveAt unlinks the Node at index 'i' returning its' data, or returns ErrIndexOutOfRange when there is no
+// such Node
+func (l *LinkedList[T]) RemoveAt(i int) (T, error) {
+	var zero T
+	if i == 0 && l.Head != nil {
+		removed := l.Head
+		l.Head = removed.Next
+		l.unlinked()
+		return removed.Data, nil
+	}
+	prev, err := l.nodeAt(i - 1)
+	if err != nil || prev.Next == nil {
+		return zero, errors.Wrapf(ErrIndexOutOfRange, "remove at %d with length %d", i, l.length)
+	}
+	removed := prev.Next
+	prev.Next = removed.Next
+	l.unlinked()
+	return removed.Data, nil
+}
+
+// nodeAt walks the LinkedList to the Node at index 'i'. The Nodes are followed rather than trusting the length,
+// which is not kept when Nodes are linked through Head and Next directly
+func (l *LinkedList[T]) nodeAt(i int) (*Node[T], error) {
+	if i < 0 {
+		return nil, errors.Wrapf(ErrIndexOutOfRange, "index %d with length %d", i, l.length)
+	}
+	node := l.Head
+	for j := 0; j < i && node != nil; j++ {
+		node = node.Next
+	}
+	if node == nil {
+		return nil, errors.Wrapf(ErrIndexOutOfRange, "index %d with length %d", i, l.length)
+	}
+	return node, nil
+}
+
+// unlinked counts a Node unlinked from the LinkedList, never letting the length fall below zero when Nodes were
+// linked through Head and Next without being counted
+func (l *LinkedList[T]) unlinked() {
+	if l.length > 0 {
+		l.length--
+	}
+}
+
+// matches compares a and b with the supplied 'equal' function, falling back to equalValues when it is nil
+func matches[T any](equal func(a, b T) bool, a, b T) bool {
+	if equal != nil {
+		return equal(a, b)
+	}
+	return equalValues(a, b)
+}
+
+// equalValues compares a and b with ==, reporting false rather than panicking when the dynamic type of the
+// values is not comparable e.g. a slice stored in a LinkedList[any]
+func equalValues[T any](a, b T) bool {
+	x, y := any(a), any(b)
+	if x == nil || y == nil {
+		return x == y
+	}
+	if reflect.TypeOf(x) != reflect.TypeOf(y) || !reflect.ValueOf(x).Comparable() {
+		return false
+	}
+	return x == y
 }
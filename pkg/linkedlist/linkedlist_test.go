@@ -4,18 +4,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+
+	"github.com/pkg/errors"
 )
 
 func Test_New_Returns_Default_Instance(t *testing.T) {
 	sut := New()
 
-	if sut.(*LinkedList).Head != nil {
-		t.Errorf("expected '<nil>' got '%+v", sut.(*LinkedList).Head)
+	if sut.(*LinkedList[any]).Head != nil {
+		t.Errorf("expected '<nil>' got '%+v", sut.(*LinkedList[any]).Head)
 	}
 }
 
 func Test_InsertFront_Makes_New_Head_Node(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertFront("Testing")
 	sut.InsertFront("One")
 	sut.InsertFront("Two")
@@ -39,7 +41,7 @@ func Test_InsertFront_Makes_New_Head_Node(t *testing.T) {
 }
 
 func Test_InsertLast(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -63,7 +65,7 @@ func Test_InsertLast(t *testing.T) {
 }
 
 func Test_InsertAfter(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -81,7 +83,7 @@ func Test_InsertAfter(t *testing.T) {
 }
 
 func Test_InsertAfter_Given_Nil_Value_Input_Is_Ignored(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -107,11 +109,14 @@ func Test_InsertAfter_Given_Nil_Value_Input_Is_Ignored(t *testing.T) {
 }
 
 func Test_GetLastNode(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 
-	actual := sut.GetLastNode()
+	actual, err := sut.GetLastNode()
+	if err != nil {
+		t.Errorf("expected '%v' got '%v'", nil, err)
+	}
 	if actual.Data != "One" {
 		t.Errorf("expected 'One' got '%s'", actual.Data)
 		marshalAndPrint(sut)
@@ -119,7 +124,7 @@ func Test_GetLastNode(t *testing.T) {
 }
 
 func Test_DeleteNodeByKey_Head_Gets_Deleted(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -135,7 +140,7 @@ func Test_DeleteNodeByKey_Head_Gets_Deleted(t *testing.T) {
 }
 
 func Test_DeleteNodeByKey_Nth_Node_Gets_Deleted(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -151,7 +156,7 @@ func Test_DeleteNodeByKey_Nth_Node_Gets_Deleted(t *testing.T) {
 }
 
 func Test_DeleteNodeByKey_Last_Node_Gets_Deleted(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -167,7 +172,7 @@ func Test_DeleteNodeByKey_Last_Node_Gets_Deleted(t *testing.T) {
 }
 
 func Test_DeleteNodeByKey_Invalid_Key_Gets_Ignored(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -187,7 +192,7 @@ func Test_DeleteNodeByKey_Invalid_Key_Gets_Ignored(t *testing.T) {
 }
 
 func Test_Reverse(t *testing.T) {
-	sut := LinkedList{}
+	sut := LinkedList[string]{}
 	sut.InsertLast("Testing")
 	sut.InsertLast("One")
 	sut.InsertLast("Two")
@@ -212,8 +217,192 @@ func Test_Reverse(t *testing.T) {
 	}
 }
 
-func marshalAndPrint(l LinkedList) {
-	b, err := json.MarshalIndent(l, "", " ")
+func Test_NewOf_Returns_Typed_Instance(t *testing.T) {
+	sut := NewOf[int]()
+	sut.InsertLast(1)
+	sut.InsertLast(2)
+
+	last, _ := sut.GetLastNode()
+	actual := last.Data + 1
+	if actual != 3 {
+		t.Errorf("expected '3' got '%d'", actual)
+	}
+}
+
+func Test_DeleteNodeByKey_Uncomparable_Values_Are_Ignored(t *testing.T) {
+	sut := New()
+	sut.InsertLast([]string{"Testing"})
+	sut.InsertLast("One")
+	sut.DeleteNodeByKey([]string{"Testing"})
+	sut.DeleteNodeByKey("One")
+
+	head := sut.(*LinkedList[any]).Head
+	if _, ok := head.Data.([]string); !ok {
+		t.Errorf("expected '[]string' got '%T'", head.Data)
+	}
+	if head.Next != nil {
+		t.Errorf("expected '<nil>' got '%+v'", head.Next)
+	}
+}
+
+func Test_NewWithEqual_DeleteNodeByKey_Uses_Equal_Func(t *testing.T) {
+	sut := NewWithEqual(func(a, b []string) bool {
+		return len(a) == len(b) && (len(a) == 0 || a[0] == b[0])
+	})
+	sut.InsertLast([]string{"Testing"})
+	sut.InsertLast([]string{"One"})
+	sut.DeleteNodeByKey([]string{"Testing"})
+
+	head := sut.(*LinkedList[[]string]).Head
+	if head.Data[0] != "One" {
+		t.Errorf("expected 'One' got '%s'", head.Data[0])
+	}
+	if head.Next != nil {
+		t.Errorf("expected '<nil>' got '%+v'", head.Next)
+	}
+}
+
+func Test_Len_Is_Maintained_Across_Operations(t *testing.T) {
+	sut := LinkedList[string]{}
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+	sut.InsertFront("One")
+	sut.InsertLast("Two")
+	sut.InsertAfter(sut.Head, "One and a half...")
+	if sut.Len() != 3 {
+		t.Errorf("expected '3' got '%d'", sut.Len())
+	}
+	sut.Reverse()
+	if sut.Len() != 3 {
+		t.Errorf("expected '3' got '%d'", sut.Len())
+	}
+	sut.DeleteNodeByKey("Invalid")
+	sut.DeleteNodeByKey("Two")
+	sut.DeleteNodeByKey("One")
+	if sut.Len() != 1 {
+		t.Errorf("expected '1' got '%d'", sut.Len())
+	}
+}
+
+func Test_At_Returns_Data_At_Index(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	for i, expected := range []string{"Testing", "One", "Two"} {
+		actual, err := sut.At(i)
+		if err != nil {
+			t.Errorf("expected '%v' got '%v'", nil, err)
+		}
+		if actual != expected {
+			t.Errorf("expected '%s' got '%s'", expected, actual)
+		}
+	}
+}
+
+func Test_At_Set_RemoveAt_Out_Of_Range_Return_ErrIndexOutOfRange(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+
+	for _, i := range []int{-1, 1} {
+		if _, err := sut.At(i); errors.Cause(err) != ErrIndexOutOfRange {
+			t.Errorf("At(%d) expected '%v' got '%v'", i, ErrIndexOutOfRange, err)
+		}
+		if err := sut.Set(i, "Invalid"); errors.Cause(err) != ErrIndexOutOfRange {
+			t.Errorf("Set(%d) expected '%v' got '%v'", i, ErrIndexOutOfRange, err)
+		}
+		if _, err := sut.RemoveAt(i); errors.Cause(err) != ErrIndexOutOfRange {
+			t.Errorf("RemoveAt(%d) expected '%v' got '%v'", i, ErrIndexOutOfRange, err)
+		}
+	}
+	for _, i := range []int{-1, 2} {
+		if err := sut.InsertAt(i, "Invalid"); errors.Cause(err) != ErrIndexOutOfRange {
+			t.Errorf("InsertAt(%d) expected '%v' got '%v'", i, ErrIndexOutOfRange, err)
+		}
+	}
+	if sut.Len() != 1 || sut.Head.Data != "Testing" {
+		t.Errorf("expected list to be unchanged")
+		marshalAndPrint(sut)
+	}
+}
+
+func Test_Set_Replaces_Data_At_Index(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+
+	if err := sut.Set(1, "Two"); err != nil {
+		t.Errorf("expected '%v' got '%v'", nil, err)
+	}
+	if sut.Head.Next.Data != "Two" {
+		t.Errorf("expected 'Two' got '%s'", sut.Head.Next.Data)
+		marshalAndPrint(sut)
+	}
+}
+
+func Test_InsertAt(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertAt(0, "One")
+	sut.InsertAt(0, "Testing")
+	sut.InsertAt(2, "Three")
+	sut.InsertAt(2, "Two")
+
+	for i, expected := range []string{"Testing", "One", "Two", "Three"} {
+		if actual, _ := sut.At(i); actual != expected {
+			t.Errorf("expected '%s' got '%s'", expected, actual)
+			marshalAndPrint(sut)
+		}
+	}
+	if sut.Len() != 4 {
+		t.Errorf("expected '4' got '%d'", sut.Len())
+	}
+}
+
+func Test_RemoveAt(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	if actual, _ := sut.RemoveAt(1); actual != "One" {
+		t.Errorf("expected 'One' got '%s'", actual)
+	}
+	if actual, _ := sut.RemoveAt(1); actual != "Two" {
+		t.Errorf("expected 'Two' got '%s'", actual)
+	}
+	if actual, _ := sut.RemoveAt(0); actual != "Testing" {
+		t.Errorf("expected 'Testing' got '%s'", actual)
+	}
+	if sut.Head != nil || sut.Len() != 0 {
+		t.Errorf("expected empty list got length '%d'", sut.Len())
+		marshalAndPrint(sut)
+	}
+}
+
+func Test_List_Linked_Through_Head_Is_Indexed_And_Never_Negative(t *testing.T) {
+	n1, n2 := NewNode(1), NewNode(2)
+	n1.Next = n2
+	sut := &LinkedList[int]{Head: n1}
+
+	if actual, err := sut.At(1); err != nil || actual != 2 {
+		t.Errorf("expected '2' and '%v' got '%d' and '%v'", nil, actual, err)
+	}
+	if _, err := sut.At(2); errors.Cause(err) != ErrIndexOutOfRange {
+		t.Errorf("expected '%v' got '%v'", ErrIndexOutOfRange, err)
+	}
+	sut.DeleteNodeByKey(1)
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+	if actual, err := sut.RemoveAt(0); err != nil || actual != 2 || sut.Len() != 0 {
+		t.Errorf("expected '2', '%v' and length '0' got '%d', '%v' and '%d'", nil, actual, err, sut.Len())
+	}
+}
+
+func marshalAndPrint[T any](l LinkedList[T]) {
+	b, err := json.MarshalIndent(&l, "", " ")
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,99 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MarshalJSON encodes the LinkedList as a flat JSON array of the data of each Node, from the Head to the last
+// Node, rather than as nested {"Head":{"Data":..,"Next":{...}}} objects
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	data, err := l.toSlice()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON replaces the Nodes of the LinkedList with the values of a JSON array
+func (l *LinkedList[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	l.fromSlice(data)
+	return nil
+}
+
+// MarshalBinary encodes the data of each Node of the LinkedList with encoding/gob. Concrete types stored in a
+// LinkedList[any] must be registered with gob.Register
+func (l *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	data, err := l.toSlice()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the Nodes of the LinkedList with values decoded from the output of MarshalBinary
+func (l *LinkedList[T]) UnmarshalBinary(b []byte) error {
+	var data []T
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&data); err != nil {
+		return err
+	}
+	l.fromSlice(data)
+	return nil
+}
+
+// String formats the LinkedList as "[a -> b -> c]". When the Nodes form a cycle the output ends with "..." after
+// the cycle has been printed once
+func (l *LinkedList[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	start := l.CycleStart()
+	seenStart := false
+	for n := l.Head; n != nil; n = n.Next {
+		if n != l.Head || seenStart {
+			sb.WriteString(" -> ")
+		}
+		if n == start {
+			if seenStart {
+				sb.WriteString("...")
+				break
+			}
+			seenStart = true
+		}
+		fmt.Fprint(&sb, n.Data)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// toSlice copies the data of each Node into a slice, or returns ErrCycleDetected when the Nodes form a cycle
+func (l *LinkedList[T]) toSlice() ([]T, error) {
+	if l.HasCycle() {
+		return nil, ErrCycleDetected
+	}
+	data := make([]T, 0, max(l.length, 0))
+	for n := l.Head; n != nil; n = n.Next {
+		data = append(data, n.Data)
+	}
+	return data, nil
+}
+
+// fromSlice replaces the Nodes of the LinkedList with a Node for each value of 'data'
+func (l *LinkedList[T]) fromSlice(data []T) {
+	l.Head = nil
+	l.length = 0
+	var tail *Node[T]
+	for _, d := range data {
+		tail = l.appendAfter(tail, d)
+	}
+}
@@ -0,0 +1,145 @@
+package linkedlist
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func Test_MarshalJSON_Produces_Flat_Array(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Testing")
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+
+	b, err := json.Marshal(&sut)
+	if err != nil {
+		t.Fatalf("expected '%v' got '%v'", nil, err)
+	}
+	if string(b) != `["Testing","One","Two"]` {
+		t.Errorf(`expected '["Testing","One","Two"]' got '%s'`, b)
+	}
+}
+
+func Test_MarshalJSON_Empty_List(t *testing.T) {
+	sut := LinkedList[string]{}
+	b, _ := json.Marshal(&sut)
+	if string(b) != `[]` {
+		t.Errorf("expected '[]' got '%s'", b)
+	}
+}
+
+func Test_MarshalJSON_Long_List_Round_Trips(t *testing.T) {
+	sut := LinkedList[int]{}
+	for i := 0; i < 100000; i++ {
+		sut.InsertFront(i)
+	}
+	expected := slices.Collect(sut.All())
+
+	b, err := json.Marshal(&sut)
+	if err != nil {
+		t.Fatalf("expected '%v' got '%v'", nil, err)
+	}
+	actual := LinkedList[int]{}
+	if err := json.Unmarshal(b, &actual); err != nil {
+		t.Fatalf("expected '%v' got '%v'", nil, err)
+	}
+	if !reflect.DeepEqual(expected, slices.Collect(actual.All())) {
+		t.Errorf("expected round tripped list to match the original")
+	}
+	if actual.Len() != 100000 {
+		t.Errorf("expected '100000' got '%d'", actual.Len())
+	}
+}
+
+func Test_Marshal_List_Linked_Through_Head(t *testing.T) {
+	n1, n2 := NewNode("Testing"), NewNode("One")
+	n1.Next = n2
+	sut := &LinkedList[string]{Head: n1, length: -1} // a length left wrong by linking Nodes directly
+
+	b, err := json.Marshal(sut)
+	if err != nil || string(b) != `["Testing","One"]` {
+		t.Errorf(`expected '["Testing","One"]' and '%v' got '%s' and '%v'`, nil, b, err)
+	}
+	if _, err := sut.MarshalBinary(); err != nil {
+		t.Errorf("expected '%v' got '%v'", nil, err)
+	}
+}
+
+func Test_UnmarshalJSON_Replaces_Existing_Nodes(t *testing.T) {
+	sut := LinkedList[string]{}
+	sut.InsertLast("Invalid")
+
+	if err := json.Unmarshal([]byte(`["Testing","One"]`), &sut); err != nil {
+		t.Fatalf("expected '%v' got '%v'", nil, err)
+	}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual([]string{"Testing", "One"}, actual) {
+		t.Errorf("expected '%v' got '%v'", []string{"Testing", "One"}, actual)
+	}
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+func Test_UnmarshalJSON_Invalid_Input_Returns_Error(t *testing.T) {
+	sut := LinkedList[int]{}
+	if err := json.Unmarshal([]byte(`{"Head":null}`), &sut); err == nil {
+		t.Errorf("expected an error got '<nil>'")
+	}
+}
+
+func Test_MarshalBinary_Round_Trips_With_Gob(t *testing.T) {
+	gob.Register(sortRecord{})
+	sut := LinkedList[any]{}
+	sut.InsertLast(sortRecord{Key: 1, Order: 2})
+	sut.InsertLast("One")
+
+	b, err := sut.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expected '%v' got '%v'", nil, err)
+	}
+	actual := LinkedList[any]{}
+	if err := actual.UnmarshalBinary(b); err != nil {
+		t.Fatalf("expected '%v' got '%v'", nil, err)
+	}
+	expected := []any{sortRecord{Key: 1, Order: 2}, "One"}
+	if !reflect.DeepEqual(expected, slices.Collect(actual.All())) {
+		t.Errorf("expected '%v' got '%v'", expected, slices.Collect(actual.All()))
+	}
+}
+
+func Test_Marshal_Cycle_Returns_ErrCycleDetected(t *testing.T) {
+	sut, _ := cyclicList(3, 1)
+	if _, err := json.Marshal(sut); err == nil {
+		t.Errorf("expected '%v' got '<nil>'", ErrCycleDetected)
+	}
+	if _, err := sut.MarshalBinary(); err != ErrCycleDetected {
+		t.Errorf("expected '%v' got '%v'", ErrCycleDetected, err)
+	}
+}
+
+func Test_String(t *testing.T) {
+	sut := LinkedList[string]{}
+	if sut.String() != "[]" {
+		t.Errorf("expected '[]' got '%s'", sut.String())
+	}
+	sut.InsertLast("a")
+	sut.InsertLast("b")
+	sut.InsertLast("c")
+	if sut.String() != "[a -> b -> c]" {
+		t.Errorf("expected '[a -> b -> c]' got '%s'", sut.String())
+	}
+}
+
+func Test_String_Cycle_Is_Printed_Once(t *testing.T) {
+	sut, _ := cyclicList(3, 1)
+	if sut.String() != "[0 -> 1 -> 2 -> ...]" {
+		t.Errorf("expected '[0 -> 1 -> 2 -> ...]' got '%s'", sut.String())
+	}
+	sut, _ = cyclicList(2, 0)
+	if sut.String() != "[0 -> 1 -> ...]" {
+		t.Errorf("expected '[0 -> 1 -> ...]' got '%s'", sut.String())
+	}
+}
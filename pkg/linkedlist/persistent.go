@@ -0,0 +1,102 @@
+package linkedlist
+
+import "iter"
+
+// persistentNode is never modified once created, so any number of PersistentList versions may share it
+type persistentNode[T any] struct {
+	data T
+	next *persistentNode[T]
+}
+
+// PersistentList is an immutable singly linked list. Operations return a new version of the list and leave the
+// receiver untouched; versions share every Node they have in common, so readers on other goroutines can hold a
+// version indefinitely without locks or copies. The zero value is an empty list
+type PersistentList[T any] struct {
+	head   *persistentNode[T]
+	length int
+}
+
+// NewPersistent constructs a PersistentList holding the supplied values in order
+func NewPersistent[T any](values ...T) PersistentList[T] {
+	var l PersistentList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = l.Cons(values[i])
+	}
+	return l
+}
+
+// Len returns the number of values in the PersistentList
+func (l PersistentList[T]) Len() int {
+	return l.length
+}
+
+// Cons returns a new version of the PersistentList with 'data' at the front, sharing every existing Node. It
+// takes constant time
+func (l PersistentList[T]) Cons(data T) PersistentList[T] {
+	return PersistentList[T]{
+		head:   &persistentNode[T]{data: data, next: l.head},
+		length: l.length + 1,
+	}
+}
+
+// Head returns the value at the front of the PersistentList and true, or the zero value and false when it is
+// empty
+func (l PersistentList[T]) Head() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	return l.head.data, true
+}
+
+// Tail returns a new version of the PersistentList without its' first value, sharing every remaining Node. It
+// takes constant time, and the tail of an empty list is empty
+func (l PersistentList[T]) Tail() PersistentList[T] {
+	if l.head == nil {
+		return l
+	}
+	return PersistentList[T]{head: l.head.next, length: l.length - 1}
+}
+
+// Append returns a new version of the PersistentList with 'data' at the end. Every existing Node leads to the
+// end of the list, so all of them are copied and the operation takes linear time; prefer Cons where possible
+func (l PersistentList[T]) Append(data T) PersistentList[T] {
+	return l.Concat(PersistentList[T]{}.Cons(data))
+}
+
+// Concat returns a new version of the PersistentList followed by the values of 'other'. The Nodes of the
+// receiver are copied while the Nodes of 'other' are shared
+func (l PersistentList[T]) Concat(other PersistentList[T]) PersistentList[T] {
+	if l.head == nil {
+		return other
+	}
+	result := PersistentList[T]{length: l.length + other.length}
+	link := &result.head
+	for n := l.head; n != nil; n = n.next {
+		copied := &persistentNode[T]{data: n.data}
+		*link = copied
+		link = &copied.next
+	}
+	*link = other.head
+	return result
+}
+
+// Reverse returns a new version of the PersistentList with its' values in reverse order
+func (l PersistentList[T]) Reverse() PersistentList[T] {
+	var result PersistentList[T]
+	for n := l.head; n != nil; n = n.next {
+		result = result.Cons(n.data)
+	}
+	return result
+}
+
+// All returns an iterator over the values of the PersistentList from front to back
+func (l PersistentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.data) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func Test_Persistent_Zero_Value_Is_Empty(t *testing.T) {
+	var sut PersistentList[string]
+	if _, ok := sut.Head(); ok || sut.Len() != 0 {
+		t.Errorf("expected empty list got length '%d'", sut.Len())
+	}
+	if tail := sut.Tail(); tail.Len() != 0 {
+		t.Errorf("expected tail of empty list to be empty")
+	}
+}
+
+func Test_Persistent_Cons_Shares_Nodes(t *testing.T) {
+	original := NewPersistent("One", "Two")
+	sut := original.Cons("Testing")
+
+	if sut.head.next != original.head {
+		t.Errorf("expected Cons to share the nodes of the original")
+	}
+	assertPersistent(t, original, "One", "Two")
+	assertPersistent(t, sut, "Testing", "One", "Two")
+}
+
+func Test_Persistent_Head_And_Tail(t *testing.T) {
+	sut := NewPersistent("Testing", "One")
+	if v, ok := sut.Head(); !ok || v != "Testing" {
+		t.Errorf("expected 'Testing' got '%s' (ok: %v)", v, ok)
+	}
+	tail := sut.Tail()
+	if tail.head != sut.head.next {
+		t.Errorf("expected Tail to share the remaining nodes")
+	}
+	assertPersistent(t, tail, "One")
+	assertPersistent(t, sut, "Testing", "One")
+}
+
+func Test_Persistent_Append_Leaves_Original_Unchanged(t *testing.T) {
+	original := NewPersistent("Testing", "One")
+	sut := original.Append("Two")
+
+	assertPersistent(t, original, "Testing", "One")
+	assertPersistent(t, sut, "Testing", "One", "Two")
+}
+
+func Test_Persistent_Concat_Shares_Other(t *testing.T) {
+	first := NewPersistent("Testing")
+	second := NewPersistent("One", "Two")
+	sut := first.Concat(second)
+
+	if sut.head.next != second.head {
+		t.Errorf("expected Concat to share the nodes of the second list")
+	}
+	assertPersistent(t, sut, "Testing", "One", "Two")
+	assertPersistent(t, first, "Testing")
+}
+
+func Test_Persistent_Reverse(t *testing.T) {
+	original := NewPersistent("Testing", "One", "Two")
+	sut := original.Reverse()
+
+	assertPersistent(t, sut, "Two", "One", "Testing")
+	assertPersistent(t, original, "Testing", "One", "Two")
+}
+
+func Test_Persistent_Versions_Read_Concurrently_With_Writer(t *testing.T) {
+	snapshot := NewPersistent(0, 1, 2)
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if actual := slices.Collect(snapshot.All()); !reflect.DeepEqual([]int{0, 1, 2}, actual) {
+					t.Errorf("expected snapshot to be unchanged got '%v'", actual)
+					return
+				}
+			}
+		}()
+	}
+	latest := snapshot
+	for i := 0; i < 1000; i++ {
+		latest = latest.Cons(i).Tail().Append(i).Cons(i)
+	}
+	wg.Wait()
+}
+
+// assertPersistent compares the values of 'l' to 'expected' and checks Len agrees
+func assertPersistent(t *testing.T, l PersistentList[string], expected ...string) {
+	t.Helper()
+	if actual := slices.Collect(l.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if l.Len() != len(expected) {
+		t.Errorf("expected length '%d' got '%d'", len(expected), l.Len())
+	}
+}
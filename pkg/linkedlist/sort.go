@@ -0,0 +1,81 @@
+package linkedlist
+
+// Sort orders the Nodes of the LinkedList in place with a stable merge sort, using 'less' to compare their data.
+// Nodes are relinked rather than reallocated so existing *Node references remain valid
+func (l *LinkedList[T]) Sort(less func(a, b T) bool) {
+	l.Head = mergeSort(l.Head, less)
+}
+
+// IsSorted reports whether the data of the Nodes of the LinkedList is ordered according to 'less'
+func (l *LinkedList[T]) IsSorted(less func(a, b T) bool) bool {
+	for n := l.Head; n != nil && n.Next != nil; n = n.Next {
+		if less(n.Next.Data, n.Data) {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSorted inserts the supplied data in a Node after every Node whose data is not greater, keeping an
+// already sorted LinkedList sorted
+func (l *LinkedList[T]) InsertSorted(data T, less func(a, b T) bool) {
+	if l.Head == nil || less(data, l.Head.Data) {
+		l.InsertFront(data)
+		return
+	}
+	prev := l.Head
+	for prev.Next != nil && !less(data, prev.Next.Data) {
+		prev = prev.Next
+	}
+	l.InsertAfter(prev, data)
+}
+
+// MergeSorted moves the Nodes of the already sorted 'other' into the already sorted LinkedList, keeping it
+// sorted. Nodes from the receiver are placed before equal Nodes from 'other', and 'other' is left empty
+func (l *LinkedList[T]) MergeSorted(other *LinkedList[T], less func(a, b T) bool) {
+	if other == nil || other == l {
+		return
+	}
+	l.Head = merge(l.Head, other.Head, less)
+	l.length += other.length
+	other.Head = nil
+	other.length = 0
+}
+
+// mergeSort sorts the chain of Nodes starting at 'head' and returns the new first Node
+func mergeSort[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+	// the fast pointer moves two Nodes for every one of the slow pointer, leaving slow at the middle
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	right := slow.Next
+	slow.Next = nil
+	return merge(mergeSort(head, less), mergeSort(right, less), less)
+}
+
+// merge interleaves two sorted chains of Nodes, preferring 'left' when data is equal so that sorting is stable
+func merge[T any](left, right *Node[T], less func(a, b T) bool) *Node[T] {
+	var head Node[T]
+	tail := &head
+	for left != nil && right != nil {
+		if less(right.Data, left.Data) {
+			tail.Next = right
+			right = right.Next
+		} else {
+			tail.Next = left
+			left = left.Next
+		}
+		tail = tail.Next
+	}
+	if left != nil {
+		tail.Next = left
+	} else {
+		tail.Next = right
+	}
+	return head.Next
+}
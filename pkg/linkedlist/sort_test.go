@@ -0,0 +1,127 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+)
+
+type sortRecord struct {
+	Key   int
+	Order int
+}
+
+func lessInt(a, b int) bool { return a < b }
+
+func lessRecord(a, b sortRecord) bool { return a.Key < b.Key }
+
+func Test_Sort_Orders_Nodes(t *testing.T) {
+	sut := LinkedList[int]{}
+	for _, v := range []int{5, 3, 9, 1, 3, 7} {
+		sut.InsertLast(v)
+	}
+
+	sut.Sort(lessInt)
+
+	expected := []int{1, 3, 3, 5, 7, 9}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if !sut.IsSorted(lessInt) {
+		t.Errorf("expected IsSorted to be 'true'")
+	}
+}
+
+func Test_Sort_Is_Stable_And_Keeps_Nodes(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sut := LinkedList[sortRecord]{}
+	expected := []sortRecord{}
+	nodes := map[*Node[sortRecord]]bool{}
+	for i := 0; i < 500; i++ {
+		record := sortRecord{Key: r.Intn(20), Order: i}
+		sut.InsertLast(record)
+		expected = append(expected, record)
+	}
+	for n := sut.Head; n != nil; n = n.Next {
+		nodes[n] = true
+	}
+	sort.SliceStable(expected, func(i, j int) bool { return expected[i].Key < expected[j].Key })
+
+	sut.Sort(lessRecord)
+
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected stable order got '%v'", actual)
+	}
+	for n := sut.Head; n != nil; n = n.Next {
+		if !nodes[n] {
+			t.Fatalf("expected sorted list to reuse the original nodes, found new node '%+v'", n)
+		}
+	}
+	if sut.Len() != 500 {
+		t.Errorf("expected '500' got '%d'", sut.Len())
+	}
+}
+
+func Test_Sort_Empty_And_Single_Node(t *testing.T) {
+	sut := LinkedList[int]{}
+	sut.Sort(lessInt)
+	if sut.Head != nil {
+		t.Errorf("expected '<nil>' got '%+v'", sut.Head)
+	}
+	sut.InsertLast(1)
+	sut.Sort(lessInt)
+	if sut.Head.Data != 1 || sut.Head.Next != nil {
+		t.Errorf("expected single node '1' got '%+v'", sut.Head)
+	}
+}
+
+func Test_IsSorted_Returns_False_When_Out_Of_Order(t *testing.T) {
+	sut := LinkedList[int]{}
+	sut.InsertLast(1)
+	sut.InsertLast(3)
+	sut.InsertLast(2)
+	if sut.IsSorted(lessInt) {
+		t.Errorf("expected IsSorted to be 'false'")
+	}
+}
+
+func Test_InsertSorted_Keeps_Order(t *testing.T) {
+	sut := LinkedList[sortRecord]{}
+	for i, key := range []int{5, 1, 3, 1, 9, 0, 3} {
+		sut.InsertSorted(sortRecord{Key: key, Order: i}, lessRecord)
+	}
+
+	expected := []sortRecord{{0, 5}, {1, 1}, {1, 3}, {3, 2}, {3, 6}, {5, 0}, {9, 4}}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 7 {
+		t.Errorf("expected '7' got '%d'", sut.Len())
+	}
+}
+
+func Test_MergeSorted_Combines_Lists(t *testing.T) {
+	sut := LinkedList[sortRecord]{}
+	other := LinkedList[sortRecord]{}
+	for _, key := range []int{1, 4, 4, 8} {
+		sut.InsertLast(sortRecord{Key: key, Order: 0})
+	}
+	for _, key := range []int{0, 4, 9} {
+		other.InsertLast(sortRecord{Key: key, Order: 1})
+	}
+
+	sut.MergeSorted(&other, lessRecord)
+
+	expected := []sortRecord{{0, 1}, {1, 0}, {4, 0}, {4, 0}, {4, 1}, {8, 0}, {9, 1}}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 7 {
+		t.Errorf("expected '7' got '%d'", sut.Len())
+	}
+	if other.Head != nil || other.Len() != 0 {
+		t.Errorf("expected other to be emptied got '%+v'", other.Head)
+	}
+}
@@ -0,0 +1,179 @@
+package linkedlist
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncLinkedList is a LinkedList which is safe for use by multiple goroutines. Rather than one lock for the whole
+// list, each Node is locked individually and traversals use hand-over-hand locking: the lock of the following
+// Node is acquired before the lock of the current Node is released. Operations on different regions of the list
+// therefore proceed concurrently, following each other down the chain instead of waiting for one another to
+// finish. The links between Nodes are held by the SyncLinkedList, so the Next of a Node returned by GetLastNode
+// is always nil and must not be set
+type SyncLinkedList[T any] struct {
+	head   syncNode[T] // sentinel whose next is the first Node, so every traversal starts by locking it
+	equal  func(a, b T) bool
+	length atomic.Int64
+}
+
+// syncNode is a Node of a SyncLinkedList along with the lock guarding it and its' link to the following
+// syncNode, kept here rather than in Node so the other lists do not carry a lock in every Node
+type syncNode[T any] struct {
+	Node[T]
+	next *syncNode[T]
+	mu   sync.Mutex
+}
+
+// NewSync constructs an instance of SyncLinkedList holding values of the comparable type T
+func NewSync[T comparable]() *SyncLinkedList[T] {
+	return &SyncLinkedList[T]{}
+}
+
+// NewSyncWithEqual constructs an instance of SyncLinkedList which uses the supplied 'equal' function to match
+// keys in DeleteNodeByKey
+func NewSyncWithEqual[T any](equal func(a, b T) bool) *SyncLinkedList[T] {
+	return &SyncLinkedList[T]{equal: equal}
+}
+
+// InsertFront inserts the supplied data in a Node at the front of the SyncLinkedList
+func (l *SyncLinkedList[T]) InsertFront(data T) {
+	newNode := &syncNode[T]{Node: Node[T]{Data: data}}
+	l.head.mu.Lock()
+	newNode.next = l.head.next
+	l.head.next = newNode
+	l.head.mu.Unlock()
+	l.length.Add(1)
+}
+
+// InsertLast inserts the supplied data in a Node at the last position in the SyncLinkedList. The error is
+// always nil
+func (l *SyncLinkedList[T]) InsertLast(data T) error {
+	newNode := &syncNode[T]{Node: Node[T]{Data: data}}
+	last := l.lockLast()
+	last.next = newNode
+	last.mu.Unlock()
+	l.length.Add(1)
+	return nil
+}
+
+// InsertAfter inserts data in the Node after the supplied prevNode. Nothing is inserted when prevNode is nil or
+// no longer belongs to the SyncLinkedList
+func (l *SyncLinkedList[T]) InsertAfter(prevNode *Node[T], data T) {
+	if prevNode == nil {
+		return
+	}
+	// walk to prevNode rather than locking it directly so a concurrently deleted Node is never linked to
+	pred := &l.head
+	pred.mu.Lock()
+	for &pred.Node != prevNode {
+		next := pred.next
+		if next == nil {
+			pred.mu.Unlock()
+			return
+		}
+		next.mu.Lock()
+		pred.mu.Unlock()
+		pred = next
+	}
+	newNode := &syncNode[T]{Node: Node[T]{Data: data}}
+	newNode.next = pred.next
+	pred.next = newNode
+	pred.mu.Unlock()
+	l.length.Add(1)
+}
+
+// GetLastNode returns the last Node of the SyncLinkedList, or nil when it is empty. The error is always nil
+func (l *SyncLinkedList[T]) GetLastNode() (*Node[T], error) {
+	last := l.lockLast()
+	defer last.mu.Unlock()
+	if last == &l.head {
+		return nil, nil
+	}
+	return &last.Node, nil
+}
+
+// DeleteNodeByKey deletes the first node in the SyncLinkedList having its' Data equal the supplied 'key'. The
+// error is always nil
+func (l *SyncLinkedList[T]) DeleteNodeByKey(key T) error {
+	pred := &l.head
+	pred.mu.Lock()
+	for curr := pred.next; curr != nil; curr = pred.next {
+		curr.mu.Lock()
+		if matches(l.equal, curr.Data, key) {
+			// curr keeps its' next so a concurrent All which already reached it can carry on
+			pred.next = curr.next
+			curr.mu.Unlock()
+			pred.mu.Unlock()
+			l.length.Add(-1)
+			return nil
+		}
+		pred.mu.Unlock()
+		pred = curr
+	}
+	pred.mu.Unlock()
+	return nil
+}
+
+// Reverse reverses the order of the Nodes in a SyncLinkedList instance. It locks every Node in order before
+// relinking any of them, so it waits for operations already under way further down the list. The error is
+// always nil
+func (l *SyncLinkedList[T]) Reverse() error {
+	l.head.mu.Lock()
+	locked := []*syncNode[T]{}
+	for n := l.head.next; n != nil; n = n.next {
+		n.mu.Lock()
+		locked = append(locked, n)
+	}
+	var prev *syncNode[T]
+	for _, n := range locked {
+		n.next = prev
+		prev = n
+	}
+	l.head.next = prev
+	for _, n := range locked {
+		n.mu.Unlock()
+	}
+	l.head.mu.Unlock()
+	return nil
+}
+
+// Len returns the number of Nodes in the SyncLinkedList
+func (l *SyncLinkedList[T]) Len() int {
+	return int(l.length.Load())
+}
+
+// All returns an iterator over the data of each Node from the front of the SyncLinkedList. No lock is held
+// while a value is yielded, so the loop body may modify the list. The traversal is weakly consistent: it
+// reflects some of the modifications made while it is under way
+func (l *SyncLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		l.head.mu.Lock()
+		n := l.head.next
+		l.head.mu.Unlock()
+		for n != nil {
+			n.mu.Lock()
+			data, next := n.Data, n.next
+			n.mu.Unlock()
+			if !yield(data) {
+				return
+			}
+			n = next
+		}
+	}
+}
+
+// lockLast walks the SyncLinkedList hand-over-hand and returns its' last Node, or the sentinel when it is empty,
+// with the lock of that Node held
+func (l *SyncLinkedList[T]) lockLast() *syncNode[T] {
+	pred := &l.head
+	pred.mu.Lock()
+	for pred.next != nil {
+		next := pred.next
+		next.mu.Lock()
+		pred.mu.Unlock()
+		pred = next
+	}
+	return pred
+}
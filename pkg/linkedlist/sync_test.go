@@ -0,0 +1,135 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func Test_SyncLinkedList_Satisfies_LinkableList(t *testing.T) {
+	var sut LinkableList[string] = NewSync[string]()
+	sut.InsertLast("Testing")
+	sut.InsertFront("One")
+
+	last, err := sut.GetLastNode()
+	if err != nil || last.Data != "Testing" {
+		t.Errorf("expected 'Testing' got '%+v' (err: %v)", last, err)
+	}
+}
+
+func Test_Sync_Operations(t *testing.T) {
+	sut := NewSync[string]()
+	if last, _ := sut.GetLastNode(); last != nil {
+		t.Errorf("expected '<nil>' got '%+v'", last)
+	}
+	sut.InsertLast("Testing")
+	sut.InsertLast("Two")
+	sut.InsertFront("Zero")
+	second := sut.head.next.next
+	sut.InsertAfter(&second.Node, "One")
+	sut.InsertAfter(nil, "Ignored")
+	sut.InsertAfter(NewNode("Detached"), "Ignored")
+
+	expected := []string{"Zero", "Testing", "One", "Two"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+
+	sut.DeleteNodeByKey("Zero")
+	sut.DeleteNodeByKey("Two")
+	sut.DeleteNodeByKey("Invalid")
+	sut.Reverse()
+
+	expected = []string{"One", "Testing"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+func Test_Sync_Concurrent_Inserts_Are_Not_Lost(t *testing.T) {
+	sut := NewSync[int]()
+	workers, perWorker := 8, 250
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				if i%2 == 0 {
+					sut.InsertFront(w*perWorker + i)
+				} else {
+					sut.InsertLast(w*perWorker + i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assertSyncContains(t, sut, workers*perWorker, func(int) bool { return true })
+}
+
+func Test_Sync_Concurrent_InsertAfter_And_Delete_On_Disjoint_Regions(t *testing.T) {
+	sut := NewSync[int]()
+	workers := 8
+	// each worker owns an anchor Node, inserts after it and deletes the values it inserted earlier
+	anchors := make([]*Node[int], workers)
+	for w := 0; w < workers; w++ {
+		sut.InsertLast(-w - 1)
+		anchors[w], _ = sut.GetLastNode()
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				sut.InsertAfter(anchors[w], w*1000+i)
+				if i%2 == 1 {
+					sut.DeleteNodeByKey(w*1000 + i - 1)
+				}
+			}
+		}(w)
+	}
+	// readers and a reverser run alongside the writers
+	for r := 0; r < 2; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				for range sut.All() {
+				}
+				sut.Reverse()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the anchors plus the odd values of each worker remain
+	assertSyncContains(t, sut, workers+workers*100, func(v int) bool { return v < 0 || v%2 == 1 })
+}
+
+// assertSyncContains checks the number of Nodes, that Len agrees, that no value is repeated and every value
+// satisfies 'valid'
+func assertSyncContains(t *testing.T, l *SyncLinkedList[int], expected int, valid func(int) bool) {
+	t.Helper()
+	actual := slices.Collect(l.All())
+	if len(actual) != expected || l.Len() != expected {
+		t.Fatalf("expected '%d' nodes got '%d' (Len: %d)", expected, len(actual), l.Len())
+	}
+	sort.Ints(actual)
+	for i, v := range actual {
+		if i > 0 && actual[i-1] == v {
+			t.Errorf("expected unique values, '%d' repeated", v)
+		}
+		if !valid(v) {
+			t.Errorf("unexpected value '%d'", v)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package linkedlist
+
+import (
+	"iter"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBlockSize is the number of values held by each block of an UnrolledList when no size is given
+const DefaultBlockSize = 64
+
+// block is a node of an UnrolledList holding up to the list's block size values in one contiguous slice
+type block[T any] struct {
+	items []T
+	next  *block[T]
+}
+
+// UnrolledList is a linear data structure which stores many values in each node rather than one, so a list of
+// small values spends far less memory on pointers and allocations, and walking it reads contiguous memory.
+// Blocks are split in half when they overflow and merged with their successor when they shrink enough to fit.
+// This package has no XOR linked list, the other memory saving list: it stores the XOR of two addresses in
+// place of pointers, hiding them from the garbage collector, which is not safe in Go
+type UnrolledList[T any] struct {
+	head      *block[T]
+	tail      *block[T]
+	blockSize int
+	equal     func(a, b T) bool
+	length    int
+}
+
+// NewUnrolled constructs an instance of UnrolledList holding up to 'blockSize' values of the comparable type T
+// in each block. DefaultBlockSize is used when 'blockSize' is less than 2
+func NewUnrolled[T comparable](blockSize int) *UnrolledList[T] {
+	return NewUnrolledWithEqual[T](blockSize, nil)
+}
+
+// NewUnrolledWithEqual constructs an instance of UnrolledList which uses the supplied 'equal' function to match
+// keys in DeleteNodeByKey
+func NewUnrolledWithEqual[T any](blockSize int, equal func(a, b T) bool) *UnrolledList[T] {
+	if blockSize < 2 {
+		blockSize = DefaultBlockSize
+	}
+	return &UnrolledList[T]{blockSize: blockSize, equal: equal}
+}
+
+// Len returns the number of values in the UnrolledList
+func (l *UnrolledList[T]) Len() int {
+	return l.length
+}
+
+// InsertFront inserts the supplied data at the front of the UnrolledList
+func (l *UnrolledList[T]) InsertFront(data T) {
+	l.InsertAt(0, data)
+}
+
+// InsertLast inserts the supplied data at the last position in the UnrolledList in constant time
+func (l *UnrolledList[T]) InsertLast(data T) {
+	if l.tail == nil || len(l.tail.items) == l.blockSize {
+		l.appendBlock()
+	}
+	l.tail.items = append(l.tail.items, data)
+	l.length++
+}
+
+// At returns the value at index 'i', or ErrIndexOutOfRange when there is no such value
+func (l *UnrolledList[T]) At(i int) (T, error) {
+	b, offset, _, err := l.locate(i)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return b.items[offset], nil
+}
+
+// Set replaces the value at index 'i', or returns ErrIndexOutOfRange when there is no such value
+func (l *UnrolledList[T]) Set(i int, data T) error {
+	b, offset, _, err := l.locate(i)
+	if err != nil {
+		return err
+	}
+	b.items[offset] = data
+	return nil
+}
+
+// InsertAt inserts data at index 'i' so that it is preceded by 'i' values. An index equal to Len appends to the
+// UnrolledList, anything past it returns ErrIndexOutOfRange
+func (l *UnrolledList[T]) InsertAt(i int, data T) error {
+	if i == l.length {
+		l.InsertLast(data)
+		return nil
+	}
+	b, offset, _, err := l.locate(i)
+	if err != nil {
+		return errors.Wrapf(ErrIndexOutOfRange, "insert at %d with length %d", i, l.length)
+	}
+	if len(b.items) == l.blockSize {
+		half := l.blockSize / 2
+		l.splitAfter(b, half)
+		if offset > half {
+			b, offset = b.next, offset-half
+		}
+	}
+	var zero T
+	b.items = append(b.items, zero)
+	copy(b.items[offset+1:], b.items[offset:])
+	b.items[offset] = data
+	l.length++
+	return nil
+}
+
+// RemoveAt removes the value at index 'i' and returns it, or returns ErrIndexOutOfRange when there is no such
+// value
+func (l *UnrolledList[T]) RemoveAt(i int) (T, error) {
+	b, offset, prev, err := l.locate(i)
+	if err != nil {
+		var zero T
+		return zero, errors.Wrapf(ErrIndexOutOfRange, "remove at %d with length %d", i, l.length)
+	}
+	removed := b.items[offset]
+	copy(b.items[offset:], b.items[offset+1:])
+	var zero T
+	b.items[len(b.items)-1] = zero
+	b.items = b.items[:len(b.items)-1]
+	l.length--
+
+	switch {
+	case len(b.items) == 0:
+		l.unlink(prev, b)
+	case b.next != nil && len(b.items)+len(b.next.items) <= l.blockSize:
+		b.items = append(b.items, b.next.items...)
+		l.unlink(b, b.next)
+	}
+	return removed, nil
+}
+
+// DeleteNodeByKey deletes the first value in the UnrolledList equal to the supplied 'key'
+func (l *UnrolledList[T]) DeleteNodeByKey(key T) {
+	i := 0
+	for b := l.head; b != nil; b = b.next {
+		for j := range b.items {
+			if matches(l.equal, b.items[j], key) {
+				l.RemoveAt(i + j)
+				return
+			}
+		}
+		i += len(b.items)
+	}
+}
+
+// Reverse reverses the order of the values in the UnrolledList by reversing the order of the blocks and the
+// values within each block
+func (l *UnrolledList[T]) Reverse() {
+	var prev *block[T]
+	current := l.head
+	l.tail = current
+	for current != nil {
+		items := current.items
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+	l.head = prev
+}
+
+// All returns an iterator over the values of the UnrolledList from front to back
+func (l *UnrolledList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for b := l.head; b != nil; b = b.next {
+			for _, v := range b.items {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// locate returns the block holding index 'i', the offset of 'i' within it and the preceding block
+func (l *UnrolledList[T]) locate(i int) (b *block[T], offset int, prev *block[T], err error) {
+	if i < 0 || i >= l.length {
+		return nil, 0, nil, errors.Wrapf(ErrIndexOutOfRange, "index %d with length %d", i, l.length)
+	}
+	for b = l.head; i >= len(b.items); b = b.next {
+		i -= len(b.items)
+		prev = b
+	}
+	return b, i, prev, nil
+}
+
+func (l *UnrolledList[T]) appendBlock() {
+	b := &block[T]{items: make([]T, 0, l.blockSize)}
+	if l.tail == nil {
+		l.head = b
+	} else {
+		l.tail.next = b
+	}
+	l.tail = b
+}
+
+// splitAfter moves the values of 'b' from index 'at' onwards into a new block linked after it
+func (l *UnrolledList[T]) splitAfter(b *block[T], at int) {
+	moved := &block[T]{items: make([]T, 0, l.blockSize), next: b.next}
+	moved.items = append(moved.items, b.items[at:]...)
+	var zero T
+	for j := at; j < len(b.items); j++ {
+		b.items[j] = zero
+	}
+	b.items = b.items[:at]
+	b.next = moved
+	if l.tail == b {
+		l.tail = moved
+	}
+}
+
+// unlink removes block 'b', which follows 'prev' or is the head when 'prev' is nil
+func (l *UnrolledList[T]) unlink(prev, b *block[T]) {
+	if prev == nil {
+		l.head = b.next
+	} else {
+		prev.next = b.next
+	}
+	if l.tail == b {
+		l.tail = prev
+	}
+}
@@ -0,0 +1,163 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func Test_Unrolled_InsertFront_InsertLast(t *testing.T) {
+	sut := NewUnrolled[string](2)
+	sut.InsertLast("One")
+	sut.InsertLast("Two")
+	sut.InsertFront("Testing")
+	sut.InsertLast("Three")
+
+	expected := []string{"Testing", "One", "Two", "Three"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 4 {
+		t.Errorf("expected '4' got '%d'", sut.Len())
+	}
+}
+
+func Test_Unrolled_Default_Block_Size(t *testing.T) {
+	sut := NewUnrolled[int](0)
+	if sut.blockSize != DefaultBlockSize {
+		t.Errorf("expected '%d' got '%d'", DefaultBlockSize, sut.blockSize)
+	}
+}
+
+func Test_Unrolled_Out_Of_Range_Returns_ErrIndexOutOfRange(t *testing.T) {
+	sut := NewUnrolled[string](4)
+	sut.InsertLast("Testing")
+
+	if _, err := sut.At(1); errors.Cause(err) != ErrIndexOutOfRange {
+		t.Errorf("At expected '%v' got '%v'", ErrIndexOutOfRange, err)
+	}
+	if err := sut.Set(-1, ""); errors.Cause(err) != ErrIndexOutOfRange {
+		t.Errorf("Set expected '%v' got '%v'", ErrIndexOutOfRange, err)
+	}
+	if err := sut.InsertAt(2, ""); errors.Cause(err) != ErrIndexOutOfRange {
+		t.Errorf("InsertAt expected '%v' got '%v'", ErrIndexOutOfRange, err)
+	}
+	if _, err := sut.RemoveAt(1); errors.Cause(err) != ErrIndexOutOfRange {
+		t.Errorf("RemoveAt expected '%v' got '%v'", ErrIndexOutOfRange, err)
+	}
+}
+
+func Test_Unrolled_DeleteNodeByKey_And_Reverse(t *testing.T) {
+	sut := NewUnrolled[int](3)
+	for i := 0; i < 10; i++ {
+		sut.InsertLast(i)
+	}
+	sut.DeleteNodeByKey(4)
+	sut.DeleteNodeByKey(42)
+	sut.Reverse()
+	sut.InsertLast(-1)
+
+	expected := []int{9, 8, 7, 6, 5, 3, 2, 1, 0, -1}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func Test_Unrolled_Matches_Slice_Reference(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sut := NewUnrolled[int](4)
+	reference := []int{}
+
+	for i := 0; i < 5000; i++ {
+		switch op := r.Intn(5); {
+		case op < 2:
+			at := r.Intn(len(reference) + 1)
+			sut.InsertAt(at, i)
+			reference = slices.Insert(reference, at, i)
+		case op < 4 && len(reference) > 0:
+			at := r.Intn(len(reference))
+			actual, err := sut.RemoveAt(at)
+			if err != nil || actual != reference[at] {
+				t.Fatalf("RemoveAt(%d) expected '%d' got '%d' (err: %v)", at, reference[at], actual, err)
+			}
+			reference = slices.Delete(reference, at, at+1)
+		case len(reference) > 0:
+			at := r.Intn(len(reference))
+			sut.Set(at, -i)
+			reference[at] = -i
+		}
+	}
+
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(reference, actual) {
+		t.Fatalf("expected '%v' got '%v'", reference, actual)
+	}
+	for i, expected := range reference {
+		if actual, _ := sut.At(i); actual != expected {
+			t.Fatalf("At(%d) expected '%d' got '%d'", i, expected, actual)
+		}
+	}
+	if sut.Len() != len(reference) {
+		t.Errorf("expected '%d' got '%d'", len(reference), sut.Len())
+	}
+	for b := sut.head; b != nil; b = b.next {
+		if len(b.items) == 0 || len(b.items) > 4 {
+			t.Errorf("expected blocks to hold 1 to 4 values got '%d'", len(b.items))
+		}
+		if b.next == nil && sut.tail != b {
+			t.Errorf("expected tail to be the last block")
+		}
+	}
+}
+
+const benchmarkListSize = 100000
+
+func BenchmarkUnrolledList_Build(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sut := NewUnrolled[int](DefaultBlockSize)
+		for j := 0; j < benchmarkListSize; j++ {
+			sut.InsertLast(j)
+		}
+	}
+}
+
+func BenchmarkLinkedList_Build(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sut := LinkedList[int]{}
+		for j := 0; j < benchmarkListSize; j++ {
+			sut.InsertFront(j)
+		}
+	}
+}
+
+func BenchmarkUnrolledList_Sum(b *testing.B) {
+	sut := NewUnrolled[int](DefaultBlockSize)
+	for j := 0; j < benchmarkListSize; j++ {
+		sut.InsertLast(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for v := range sut.All() {
+			sum += v
+		}
+	}
+}
+
+func BenchmarkLinkedList_Sum(b *testing.B) {
+	sut := LinkedList[int]{}
+	for j := 0; j < benchmarkListSize; j++ {
+		sut.InsertFront(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for v := range sut.All() {
+			sum += v
+		}
+	}
+}
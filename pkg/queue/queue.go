@@ -0,0 +1,86 @@
+package queue
+
+import "sync/atomic"
+
+// node mirrors linkedlist.Node with a Next reference which is read and swapped atomically
+type node[T any] struct {
+	Data T
+	Next atomic.Pointer[node[T]]
+}
+
+// Queue is a lock-free first in first out queue which is safe for use by multiple producers and consumers. It
+// implements the Michael-Scott algorithm: a singly linked chain of nodes starting with a dummy node, where
+// Enqueue swings the tail forward with compare-and-swap and Dequeue does the same with the head. A goroutine
+// which finds the tail lagging behind helps move it along instead of waiting
+type Queue[T any] struct {
+	head   atomic.Pointer[node[T]]
+	tail   atomic.Pointer[node[T]]
+	length atomic.Int64
+}
+
+// New constructs an empty instance of Queue
+func New[T any]() *Queue[T] {
+	q := &Queue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue adds the supplied data at the back of the Queue
+func (q *Queue[T]) Enqueue(data T) {
+	newNode := &node[T]{Data: data}
+	for {
+		tail := q.tail.Load()
+		next := tail.Next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// another Enqueue linked its' node but has not moved the tail yet
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.Next.CompareAndSwap(nil, newNode) {
+			q.tail.CompareAndSwap(tail, newNode)
+			q.length.Add(1)
+			return
+		}
+	}
+}
+
+// Dequeue removes and returns the data at the front of the Queue, or the zero value and false when it is empty.
+// The node holding the returned data becomes the new dummy node, so the data stays reachable until the
+// following Dequeue
+func (q *Queue[T]) Dequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.Next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		data := next.Data
+		if q.head.CompareAndSwap(head, next) {
+			q.length.Add(-1)
+			return data, true
+		}
+	}
+}
+
+// Len returns the number of items in the Queue. While other goroutines are using the Queue the result is only
+// an estimate
+func (q *Queue[T]) Len() int {
+	if n := q.length.Load(); n > 0 {
+		return int(n)
+	}
+	return 0
+}
@@ -0,0 +1,150 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/meads/datastructures/pkg/linkedlist"
+)
+
+func TestDequeue_Returns_False_When_Empty(t *testing.T) {
+	sut := New[string]()
+	actual, ok := sut.Dequeue()
+	if ok || actual != "" {
+		t.Errorf("expected '' and false got '%s' and %v", actual, ok)
+	}
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+}
+
+func TestEnqueue_Dequeue_Is_First_In_First_Out(t *testing.T) {
+	sut := New[string]()
+	sut.Enqueue("Testing")
+	sut.Enqueue("One")
+	sut.Enqueue("Two")
+	if sut.Len() != 3 {
+		t.Errorf("expected '3' got '%d'", sut.Len())
+	}
+
+	for _, expected := range []string{"Testing", "One", "Two"} {
+		actual, ok := sut.Dequeue()
+		if !ok || actual != expected {
+			t.Errorf("expected '%s' got '%s' (ok: %v)", expected, actual, ok)
+		}
+	}
+	if _, ok := sut.Dequeue(); ok {
+		t.Errorf("expected queue to be empty")
+	}
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+}
+
+func TestQueue_Concurrent_Producers_And_Consumers(t *testing.T) {
+	sut := New[int]()
+	producers, consumers, perProducer := 4, 4, 5000
+	total := producers * perProducer
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				sut.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	results := make(chan []int, consumers)
+	var remaining sync.WaitGroup
+	remaining.Add(total)
+	done := make(chan struct{})
+	go func() {
+		remaining.Wait()
+		close(done)
+	}()
+	for c := 0; c < consumers; c++ {
+		go func() {
+			received := []int{}
+			for {
+				select {
+				case <-done:
+					results <- received
+					return
+				default:
+				}
+				if v, ok := sut.Dequeue(); ok {
+					received = append(received, v)
+					remaining.Done()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make([]bool, total)
+	for c := 0; c < consumers; c++ {
+		received := <-results
+		// values from one producer must reach each consumer in the order they were enqueued
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, v := range received {
+			if seen[v] {
+				t.Fatalf("expected each value once, '%d' dequeued twice", v)
+			}
+			seen[v] = true
+			p := v / perProducer
+			if v <= last[p] {
+				t.Fatalf("expected FIFO order per producer, '%d' after '%d'", v, last[p])
+			}
+			last[p] = v
+		}
+	}
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("expected '%d' to be dequeued", v)
+		}
+	}
+	if sut.Len() != 0 {
+		t.Errorf("expected '0' got '%d'", sut.Len())
+	}
+}
+
+func BenchmarkQueue(b *testing.B) {
+	sut := New[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			sut.Enqueue(1)
+			sut.Dequeue()
+		}
+	})
+}
+
+func BenchmarkChannel(b *testing.B) {
+	sut := make(chan int, 1024)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			sut <- 1
+			<-sut
+		}
+	})
+}
+
+func BenchmarkMutexLinkedList(b *testing.B) {
+	var mu sync.Mutex
+	sut := linkedlist.LinkedList[int]{}
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			sut.InsertLast(1)
+			mu.Unlock()
+			mu.Lock()
+			sut.RemoveAt(0)
+			mu.Unlock()
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package ringbuffer
+
+import (
+	"iter"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrFull is an error value for when a value is pushed to a full RingBuffer in Reject mode
+	ErrFull = errors.New("ring buffer is full")
+
+	// ErrEmpty is an error value for when a value is read from an empty RingBuffer
+	ErrEmpty = errors.New("ring buffer is empty")
+)
+
+// Mode decides what Push does when the RingBuffer is full
+type Mode int
+
+const (
+	// Reject makes Push return ErrFull, leaving the RingBuffer unchanged
+	Reject Mode = iota
+	// Overwrite makes Push discard the oldest value to make room
+	Overwrite
+)
+
+// RingBuffer is a fixed capacity first in first out queue stored in a slice which is reused as a circle, so
+// pushing and popping never allocate
+type RingBuffer[T any] struct {
+	items  []T
+	start  int // index of the oldest value
+	length int
+	mode   Mode
+}
+
+// New constructs an empty instance of RingBuffer able to hold 'capacity' values, treated as 1 when less
+func New[T any](capacity int, mode Mode) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBuffer[T]{
+		items: make([]T, capacity),
+		mode:  mode,
+	}
+}
+
+// Len returns the number of values in the RingBuffer
+func (r *RingBuffer[T]) Len() int {
+	return r.length
+}
+
+// Cap returns the number of values the RingBuffer can hold
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.items)
+}
+
+// IsFull reports whether the RingBuffer holds as many values as its' capacity
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.length == len(r.items)
+}
+
+// Push adds the supplied data as the newest value. When the RingBuffer is full it returns ErrFull in Reject mode,
+// and discards the oldest value in Overwrite mode
+func (r *RingBuffer[T]) Push(data T) error {
+	if r.IsFull() {
+		if r.mode == Reject {
+			return ErrFull
+		}
+		r.items[r.start] = data
+		r.start = (r.start + 1) % len(r.items)
+		return nil
+	}
+	r.items[(r.start+r.length)%len(r.items)] = data
+	r.length++
+	return nil
+}
+
+// Pop removes and returns the oldest value, or returns ErrEmpty
+func (r *RingBuffer[T]) Pop() (T, error) {
+	var zero T
+	if r.length == 0 {
+		return zero, ErrEmpty
+	}
+	data := r.items[r.start]
+	r.items[r.start] = zero
+	r.start = (r.start + 1) % len(r.items)
+	r.length--
+	return data, nil
+}
+
+// Peek returns the oldest value without removing it, or returns ErrEmpty
+func (r *RingBuffer[T]) Peek() (T, error) {
+	if r.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return r.items[r.start], nil
+}
+
+// All returns an iterator over the values of the RingBuffer from oldest to newest
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.length; i++ {
+			if !yield(r.items[(r.start+i)%len(r.items)]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package ringbuffer
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNew_Minimum_Capacity_Is_One(t *testing.T) {
+	sut := New[int](0, Reject)
+	if sut.Cap() != 1 {
+		t.Errorf("expected '1' got '%d'", sut.Cap())
+	}
+}
+
+func TestPop_Peek_Return_ErrEmpty(t *testing.T) {
+	sut := New[int](2, Reject)
+	if _, err := sut.Pop(); err != ErrEmpty {
+		t.Errorf("expected '%v' got '%v'", ErrEmpty, err)
+	}
+	if _, err := sut.Peek(); err != ErrEmpty {
+		t.Errorf("expected '%v' got '%v'", ErrEmpty, err)
+	}
+}
+
+func TestPush_Pop_Is_First_In_First_Out_Across_Wrap(t *testing.T) {
+	sut := New[int](6, Reject)
+	actual := []int{}
+	for i := 0; i < 10; i++ {
+		if err := sut.Push(i); err != nil {
+			t.Fatalf("expected '%v' got '%v'", nil, err)
+		}
+		if i%2 == 1 {
+			v, _ := sut.Pop()
+			actual = append(actual, v)
+		}
+	}
+	for sut.Len() > 0 {
+		v, _ := sut.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, actual) {
+		t.Errorf("expected values in push order got '%v'", actual)
+	}
+}
+
+func TestPush_Reject_Mode_Returns_ErrFull(t *testing.T) {
+	sut := New[string](2, Reject)
+	sut.Push("Testing")
+	sut.Push("One")
+
+	if err := sut.Push("Two"); err != ErrFull {
+		t.Errorf("expected '%v' got '%v'", ErrFull, err)
+	}
+	if !sut.IsFull() {
+		t.Errorf("expected IsFull to be 'true'")
+	}
+	expected := []string{"Testing", "One"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+}
+
+func TestPush_Overwrite_Mode_Discards_Oldest(t *testing.T) {
+	sut := New[string](2, Overwrite)
+	sut.Push("Testing")
+	sut.Push("One")
+
+	if err := sut.Push("Two"); err != nil {
+		t.Errorf("expected '%v' got '%v'", nil, err)
+	}
+	if v, _ := sut.Peek(); v != "One" {
+		t.Errorf("expected 'One' got '%s'", v)
+	}
+	expected := []string{"One", "Two"}
+	if actual := slices.Collect(sut.All()); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected '%v' got '%v'", expected, actual)
+	}
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
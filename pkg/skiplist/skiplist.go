@@ -0,0 +1,203 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	// DefaultP is the probability that a Node reaching one level also reaches the next
+	DefaultP = 0.5
+
+	// DefaultMaxLevel bounds the height of a tower, enough for 2^32 entries with DefaultP
+	DefaultMaxLevel = 32
+)
+
+// Node is a linkedlist.Node grown into a tower: Next[0] links every Node in key order like a sorted LinkedList,
+// and each higher level links a random subset of the level below, letting searches skip ahead
+type Node[K cmp.Ordered, V any] struct {
+	Key   K
+	Value V
+	Next  []*Node[K, V]
+}
+
+// Config holds the tuning parameters of a SkipList
+type Config struct {
+	// P is the probability that a Node is promoted to the next level; DefaultP is used when it is not in (0, 1)
+	P float64
+	// MaxLevel is the maximum number of levels; DefaultMaxLevel is used when it is not positive
+	MaxLevel int
+	// Rand draws the random levels, seed it for deterministic tests. A randomly seeded source is used when nil
+	Rand *rand.Rand
+}
+
+// SkipList is an ordered map with O(log n) expected Put, Get and Delete, built from sorted linked lists stacked
+// in levels
+type SkipList[K cmp.Ordered, V any] struct {
+	head   *Node[K, V] // sentinel tower of MaxLevel height whose Key is never compared
+	level  int         // number of levels currently in use
+	length int
+	p      float64
+	rand   *rand.Rand
+}
+
+// New constructs an empty instance of SkipList using the default Config
+func New[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewWithConfig[K, V](Config{})
+}
+
+// NewWithConfig constructs an empty instance of SkipList with the supplied Config
+func NewWithConfig[K cmp.Ordered, V any](c Config) *SkipList[K, V] {
+	if c.P <= 0 || c.P >= 1 {
+		c.P = DefaultP
+	}
+	if c.MaxLevel <= 0 {
+		c.MaxLevel = DefaultMaxLevel
+	}
+	if c.Rand == nil {
+		c.Rand = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	return &SkipList[K, V]{
+		head:  &Node[K, V]{Next: make([]*Node[K, V], c.MaxLevel)},
+		level: 1,
+		p:     c.P,
+		rand:  c.Rand,
+	}
+}
+
+// Len returns the number of entries in the SkipList
+func (s *SkipList[K, V]) Len() int {
+	return s.length
+}
+
+// Put associates 'value' with 'key', replacing the value of an existing entry
+func (s *SkipList[K, V]) Put(key K, value V) {
+	update := s.predecessors(key)
+	if next := update[0].Next[0]; next != nil && next.Key == key {
+		next.Value = value
+		return
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+		s.level = level
+	}
+	newNode := &Node[K, V]{Key: key, Value: value, Next: make([]*Node[K, V], level)}
+	for i := 0; i < level; i++ {
+		newNode.Next[i] = update[i].Next[i]
+		update[i].Next[i] = newNode
+	}
+	s.length++
+}
+
+// Get returns the value associated with 'key' and true, or the zero value and false when there is no such entry
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.ceilingNode(key); n != nil && n.Key == key {
+		return n.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes the entry for 'key', reporting whether there was one
+func (s *SkipList[K, V]) Delete(key K) bool {
+	update := s.predecessors(key)
+	target := update[0].Next[0]
+	if target == nil || target.Key != key {
+		return false
+	}
+	for i := 0; i < len(target.Next); i++ {
+		update[i].Next[i] = target.Next[i]
+	}
+	for s.level > 1 && s.head.Next[s.level-1] == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// Floor returns the entry with the greatest key less than or equal to 'key', with false when there is none
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	n := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.Next[i] != nil && n.Next[i].Key <= key {
+			n = n.Next[i]
+		}
+	}
+	if n == s.head {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.Key, n.Value, true
+}
+
+// Ceiling returns the entry with the least key greater than or equal to 'key', with false when there is none
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	n := s.ceilingNode(key)
+	if n == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.Key, n.Value, true
+}
+
+// All returns an iterator over every entry of the SkipList in ascending key order
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return s.walk(s.head.Next[0], func(K) bool { return true })
+}
+
+// Range returns an iterator over the entries with keys from 'from' inclusive to 'to' exclusive, in ascending
+// key order. Finding the first entry takes O(log n) and each further entry O(1)
+func (s *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return s.walk(s.ceilingNode(from), func(k K) bool { return k < to })
+}
+
+// walk yields the entries along the bottom level starting at 'start' for as long as 'within' holds
+func (s *SkipList[K, V]) walk(start *Node[K, V], within func(K) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := start; n != nil && within(n.Key); n = n.Next[0] {
+			if !yield(n.Key, n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// predecessors returns, for every level, the last Node whose key is less than 'key'
+func (s *SkipList[K, V]) predecessors(key K) []*Node[K, V] {
+	update := make([]*Node[K, V], len(s.head.Next))
+	n := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.Next[i] != nil && n.Next[i].Key < key {
+			n = n.Next[i]
+		}
+		update[i] = n
+	}
+	return update
+}
+
+// ceilingNode returns the first Node whose key is greater than or equal to 'key', or nil
+func (s *SkipList[K, V]) ceilingNode(key K) *Node[K, V] {
+	n := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.Next[i] != nil && n.Next[i].Key < key {
+			n = n.Next[i]
+		}
+	}
+	return n.Next[0]
+}
+
+// randomLevel draws the height of a new tower: each further level is added with probability p
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < len(s.head.Next) && s.rand.Float64() < s.p {
+		level++
+	}
+	return level
+}
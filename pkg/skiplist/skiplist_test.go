@@ -0,0 +1,173 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func newSeeded() *SkipList[int, string] {
+	return NewWithConfig[int, string](Config{Rand: rand.New(rand.NewPCG(1, 2))})
+}
+
+func TestGet_Returns_False_When_Key_Is_Missing(t *testing.T) {
+	sut := New[string, int]()
+	if v, ok := sut.Get("missing"); ok || v != 0 {
+		t.Errorf("expected '0' and false got '%d' and %v", v, ok)
+	}
+}
+
+func TestPut_Get_Replace(t *testing.T) {
+	sut := newSeeded()
+	sut.Put(2, "two")
+	sut.Put(1, "one")
+	sut.Put(2, "TWO")
+
+	if v, ok := sut.Get(2); !ok || v != "TWO" {
+		t.Errorf("expected 'TWO' got '%s' (ok: %v)", v, ok)
+	}
+	if v, ok := sut.Get(1); !ok || v != "one" {
+		t.Errorf("expected 'one' got '%s' (ok: %v)", v, ok)
+	}
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+func TestDelete(t *testing.T) {
+	sut := newSeeded()
+	for i := 0; i < 10; i++ {
+		sut.Put(i, "")
+	}
+	if !sut.Delete(5) {
+		t.Errorf("expected Delete of existing key to be 'true'")
+	}
+	if sut.Delete(5) || sut.Delete(42) {
+		t.Errorf("expected Delete of missing key to be 'false'")
+	}
+	if _, ok := sut.Get(5); ok {
+		t.Errorf("expected '5' to be deleted")
+	}
+	if sut.Len() != 9 {
+		t.Errorf("expected '9' got '%d'", sut.Len())
+	}
+}
+
+func TestFloor_Ceiling(t *testing.T) {
+	sut := newSeeded()
+	for _, k := range []int{10, 20, 30} {
+		sut.Put(k, "")
+	}
+
+	cases := []struct {
+		key                  int
+		floor, ceiling       int
+		hasFloor, hasCeiling bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{15, 10, 20, true, true},
+		{30, 30, 30, true, true},
+		{35, 30, 0, true, false},
+	}
+	for _, c := range cases {
+		if k, _, ok := sut.Floor(c.key); k != c.floor || ok != c.hasFloor {
+			t.Errorf("Floor(%d) expected '%d' (%v) got '%d' (%v)", c.key, c.floor, c.hasFloor, k, ok)
+		}
+		if k, _, ok := sut.Ceiling(c.key); k != c.ceiling || ok != c.hasCeiling {
+			t.Errorf("Ceiling(%d) expected '%d' (%v) got '%d' (%v)", c.key, c.ceiling, c.hasCeiling, k, ok)
+		}
+	}
+}
+
+func TestRange_Yields_Half_Open_Interval_In_Order(t *testing.T) {
+	sut := newSeeded()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		sut.Put(k, "")
+	}
+
+	actual := []int{}
+	for k := range sut.Range(15, 40) {
+		actual = append(actual, k)
+	}
+	if !reflect.DeepEqual([]int{20, 30}, actual) {
+		t.Errorf("expected '%v' got '%v'", []int{20, 30}, actual)
+	}
+
+	actual = []int{}
+	for k := range sut.Range(0, 100) {
+		if k > 20 {
+			break
+		}
+		actual = append(actual, k)
+	}
+	if !reflect.DeepEqual([]int{10, 20}, actual) {
+		t.Errorf("expected '%v' got '%v'", []int{10, 20}, actual)
+	}
+}
+
+func TestSkipList_Matches_Map_Reference(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	sut := NewWithConfig[int, int](Config{P: 0.25, MaxLevel: 12, Rand: rand.New(rand.NewPCG(5, 6))})
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := r.IntN(500)
+		switch r.IntN(3) {
+		case 0, 1:
+			sut.Put(k, i)
+			reference[k] = i
+		case 2:
+			_, existed := reference[k]
+			if sut.Delete(k) != existed {
+				t.Fatalf("Delete(%d) expected '%v'", k, existed)
+			}
+			delete(reference, k)
+		}
+	}
+
+	keys := []int{}
+	for k := range reference {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	actual := []int{}
+	for k, v := range sut.All() {
+		if reference[k] != v {
+			t.Errorf("expected '%d' for key '%d' got '%d'", reference[k], k, v)
+		}
+		actual = append(actual, k)
+	}
+	if !reflect.DeepEqual(keys, actual) {
+		t.Errorf("expected keys '%v' got '%v'", keys, actual)
+	}
+	if sut.Len() != len(reference) {
+		t.Errorf("expected '%d' got '%d'", len(reference), sut.Len())
+	}
+}
+
+func TestNewWithConfig_Same_Seed_Builds_Same_Towers(t *testing.T) {
+	heights := func() []int {
+		sut := newSeeded()
+		for i := 0; i < 100; i++ {
+			sut.Put(i, "")
+		}
+		h := []int{}
+		for n := sut.head.Next[0]; n != nil; n = n.Next[0] {
+			h = append(h, len(n.Next))
+		}
+		return h
+	}
+	if !reflect.DeepEqual(heights(), heights()) {
+		t.Errorf("expected equal seeds to produce equal tower heights")
+	}
+}
+
+func BenchmarkPut(b *testing.B) {
+	sut := New[int, int]()
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < b.N; i++ {
+		sut.Put(r.Int(), i)
+	}
+}
@@ -0,0 +1,92 @@
+package stack
+
+import (
+	"github.com/meads/datastructures/pkg/linkedlist"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrEmpty is an error value for when a value is read from an empty stack
+	ErrEmpty = errors.New("stack is empty")
+)
+
+// Stack is a last in first out collection backed by a linkedlist.LinkedList, whose Head is the top of the Stack
+type Stack[T any] struct {
+	list linkedlist.LinkedList[T]
+}
+
+// New constructs an empty instance of Stack
+func New[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// Push adds the supplied data to the top of the Stack
+func (s *Stack[T]) Push(data T) {
+	s.list.InsertFront(data)
+}
+
+// Pop removes and returns the value at the top of the Stack, or returns ErrEmpty
+func (s *Stack[T]) Pop() (T, error) {
+	if s.list.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.list.RemoveAt(0)
+}
+
+// Peek returns the value at the top of the Stack without removing it, or returns ErrEmpty
+func (s *Stack[T]) Peek() (T, error) {
+	if s.list.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.list.Head.Data, nil
+}
+
+// Len returns the number of values in the Stack
+func (s *Stack[T]) Len() int {
+	return s.list.Len()
+}
+
+// SliceStack is a last in first out collection backed by a slice, whose last element is the top of the stack.
+// It offers the same methods as Stack for comparison
+type SliceStack[T any] struct {
+	items []T
+}
+
+// NewSlice constructs an empty instance of SliceStack
+func NewSlice[T any]() *SliceStack[T] {
+	return &SliceStack[T]{}
+}
+
+// Push adds the supplied data to the top of the SliceStack
+func (s *SliceStack[T]) Push(data T) {
+	s.items = append(s.items, data)
+}
+
+// Pop removes and returns the value at the top of the SliceStack, or returns ErrEmpty
+func (s *SliceStack[T]) Pop() (T, error) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, ErrEmpty
+	}
+	last := len(s.items) - 1
+	data := s.items[last]
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return data, nil
+}
+
+// Peek returns the value at the top of the SliceStack without removing it, or returns ErrEmpty
+func (s *SliceStack[T]) Peek() (T, error) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.items[len(s.items)-1], nil
+}
+
+// Len returns the number of values in the SliceStack
+func (s *SliceStack[T]) Len() int {
+	return len(s.items)
+}
@@ -0,0 +1,72 @@
+package stack
+
+import "testing"
+
+// stacker is the set of methods shared by Stack and SliceStack so both are held to the same tests
+type stacker[T any] interface {
+	Push(data T)
+	Pop() (T, error)
+	Peek() (T, error)
+	Len() int
+}
+
+var implementations = map[string]func() stacker[string]{
+	"Stack":      func() stacker[string] { return New[string]() },
+	"SliceStack": func() stacker[string] { return NewSlice[string]() },
+}
+
+func TestPop_Peek_Return_ErrEmpty(t *testing.T) {
+	for name, newStack := range implementations {
+		sut := newStack()
+		if _, err := sut.Pop(); err != ErrEmpty {
+			t.Errorf("%s: expected '%v' got '%v'", name, ErrEmpty, err)
+		}
+		if _, err := sut.Peek(); err != ErrEmpty {
+			t.Errorf("%s: expected '%v' got '%v'", name, ErrEmpty, err)
+		}
+	}
+}
+
+func TestPush_Pop_Is_Last_In_First_Out(t *testing.T) {
+	for name, newStack := range implementations {
+		sut := newStack()
+		sut.Push("Testing")
+		sut.Push("One")
+		sut.Push("Two")
+
+		if v, _ := sut.Peek(); v != "Two" {
+			t.Errorf("%s: expected 'Two' got '%s'", name, v)
+		}
+		if sut.Len() != 3 {
+			t.Errorf("%s: expected '3' got '%d'", name, sut.Len())
+		}
+		for _, expected := range []string{"Two", "One", "Testing"} {
+			if v, err := sut.Pop(); err != nil || v != expected {
+				t.Errorf("%s: expected '%s' got '%s' (err: %v)", name, expected, v, err)
+			}
+		}
+		if sut.Len() != 0 {
+			t.Errorf("%s: expected '0' got '%d'", name, sut.Len())
+		}
+	}
+}
+
+func benchmarkPushPop(b *testing.B, sut stacker[string]) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			sut.Push("Testing")
+		}
+		for j := 0; j < 100; j++ {
+			sut.Pop()
+		}
+	}
+}
+
+func BenchmarkStack(b *testing.B) {
+	benchmarkPushPop(b, New[string]())
+}
+
+func BenchmarkSliceStack(b *testing.B) {
+	benchmarkPushPop(b, NewSlice[string]())
+}
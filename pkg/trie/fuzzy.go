@@ -0,0 +1,118 @@
+package trie
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// FuzzyMode decides which keys FuzzySearch compares with the query
+type FuzzyMode int
+
+const (
+	// FuzzyWord matches keys which are within the edit distance of the query
+	FuzzyWord FuzzyMode = iota
+	// FuzzyPrefix matches keys starting with a prefix which is within the edit distance of the query, so
+	// autocomplete tolerates typos in what has been typed so far
+	FuzzyPrefix
+)
+
+// FuzzyConfig holds the optional settings of FuzzySearchWithConfig
+type FuzzyConfig struct {
+	// Mode decides whether whole keys or prefixes of keys are compared with the query
+	Mode FuzzyMode
+	// Transpositions counts swapping two adjacent runes as a single edit (the optimal string alignment variant of
+	// the Damerau-Levenshtein distance) rather than two
+	Transpositions bool
+}
+
+// FuzzyMatch is a key found by FuzzySearch along with its' edit distance from the query. With FuzzyPrefix the
+// Distance is that of the closest prefix of the key
+type FuzzyMatch struct {
+	Key      string
+	Distance int
+}
+
+// FuzzySearch returns every key within 'maxEdits' insertions, deletions or substitutions of runes from 'query',
+// ordered by distance and then by key
+func (m *Map[V]) FuzzySearch(query string, maxEdits int) []FuzzyMatch {
+	return m.FuzzySearchWithConfig(query, maxEdits, FuzzyConfig{})
+}
+
+// FuzzySearchWithConfig returns the keys within 'maxEdits' of 'query' like FuzzySearch, using the supplied
+// FuzzyConfig. One row of the edit distance table is computed per node, shared by every key below it, and
+// subtrees are skipped once no entry of the row is within 'maxEdits'
+func (m *Map[V]) FuzzySearchWithConfig(query string, maxEdits int, c FuzzyConfig) []FuzzyMatch {
+	matches := []FuzzyMatch{}
+	if maxEdits < 0 {
+		return matches
+	}
+
+	s := fuzzySearch[V]{
+		query:    []rune(query),
+		maxEdits: maxEdits,
+		config:   c,
+		matches:  &matches,
+	}
+	// the row of the root holds the distances from the empty string to each prefix of the query
+	row := make([]int, len(s.query)+1)
+	for j := range row {
+		row[j] = j
+	}
+	s.visit(m.RootNode, nil, row, nil, 0, row[len(row)-1])
+
+	slices.SortFunc(matches, func(a, b FuzzyMatch) int {
+		if c := cmp.Compare(a.Distance, b.Distance); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+	return matches
+}
+
+type fuzzySearch[V any] struct {
+	query    []rune
+	maxEdits int
+	config   FuzzyConfig
+	matches  *[]FuzzyMatch
+}
+
+// visit collects the matches at or below 'node', whose key is held in 'key', where 'row[j]' is the edit distance
+// between the key and the first 'j' runes of the query, 'previousRow' and 'letter' are those of its' parent for
+// transpositions and 'closest' is the smallest distance of any prefix of the key from the whole query
+func (s *fuzzySearch[V]) visit(node *MapNode[V], key []byte, row, previousRow []int, letter rune, closest int) {
+	distance := row[len(row)-1]
+	closest = min(closest, distance)
+
+	if node.CompletesString {
+		if s.config.Mode == FuzzyPrefix && closest <= s.maxEdits {
+			*s.matches = append(*s.matches, FuzzyMatch{Key: string(key), Distance: closest})
+		} else if distance <= s.maxEdits {
+			*s.matches = append(*s.matches, FuzzyMatch{Key: string(key), Distance: distance})
+		}
+	}
+
+	// once a prefix is close enough every key below it matches, otherwise stop when no alignment can recover
+	if !(s.config.Mode == FuzzyPrefix && closest <= s.maxEdits) && slices.Min(row) > s.maxEdits {
+		return
+	}
+
+	for _, childNode := range node.Children {
+		childLetter := childNode.Letter
+		nextRow := make([]int, len(row))
+		nextRow[0] = row[0] + 1
+		for j := 1; j < len(row); j++ {
+			substitution := row[j-1]
+			if s.query[j-1] != childLetter {
+				substitution++
+			}
+			nextRow[j] = min(row[j]+1, nextRow[j-1]+1, substitution)
+			if s.config.Transpositions && previousRow != nil && j > 1 &&
+				s.query[j-1] == letter && s.query[j-2] == childLetter {
+				nextRow[j] = min(nextRow[j], previousRow[j-2]+1)
+			}
+		}
+		s.visit(childNode, utf8.AppendRune(key, childLetter), nextRow, row, childLetter, closest)
+	}
+}
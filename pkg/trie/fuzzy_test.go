@@ -0,0 +1,151 @@
+package trie
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestFuzzySearch_Returns_Words_Within_Edits(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"apple", "apply", "ample", "maple", "apples", "banana"} {
+		sut.Insert(w)
+	}
+
+	expected := []FuzzyMatch{{"apple", 0}, {"ample", 1}, {"apples", 1}, {"apply", 1}}
+	if actual := sut.FuzzySearch("apple", 1); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+	if actual := sut.FuzzySearch("aplpe", 0); len(actual) != 0 {
+		t.Errorf("expected no matches got '%#v'", actual)
+	}
+	if actual := sut.FuzzySearch("apple", -1); len(actual) != 0 {
+		t.Errorf("expected no matches for negative edits got '%#v'", actual)
+	}
+}
+
+func TestFuzzySearch_Transpositions_Count_As_One_Edit(t *testing.T) {
+	sut := NewTrie()
+	sut.Insert("apple")
+	sut.Insert("naïve")
+
+	if actual := sut.FuzzySearch("aplpe", 1); len(actual) != 0 {
+		t.Errorf("expected a transposition to cost '2' edits without Transpositions got '%#v'", actual)
+	}
+	expected := []FuzzyMatch{{"apple", 1}}
+	actual := sut.FuzzySearchWithConfig("aplpe", 1, FuzzyConfig{Transpositions: true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+	expected = []FuzzyMatch{{"naïve", 1}}
+	actual = sut.FuzzySearchWithConfig("nïave", 1, FuzzyConfig{Transpositions: true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+}
+
+func TestFuzzySearch_Prefix_Mode_Tolerates_Typos_While_Typing(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"aardvark", "aardwolf", "abacus", "zebra"} {
+		sut.Insert(w)
+	}
+
+	expected := []FuzzyMatch{{"aardvark", 1}, {"aardwolf", 1}}
+	actual := sut.FuzzySearchWithConfig("asrd", 1, FuzzyConfig{Mode: FuzzyPrefix})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+	if actual := sut.FuzzySearch("asrd", 1); len(actual) != 0 {
+		t.Errorf("expected no whole word matches got '%#v'", actual)
+	}
+}
+
+func TestFuzzySearch_Matches_Reference_For_Random_Words(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	letters := []rune("abcü")
+	randomWord := func() string {
+		w := make([]rune, r.Intn(6))
+		for i := range w {
+			w[i] = letters[r.Intn(len(letters))]
+		}
+		return string(w)
+	}
+
+	sut := NewMap[int]()
+	words := []string{}
+	for i := 0; i < 200; i++ {
+		w := randomWord()
+		if _, ok := sut.Get(w); !ok {
+			words = append(words, w)
+		}
+		sut.Put(w, i)
+	}
+
+	for i := 0; i < 200; i++ {
+		query, maxEdits := randomWord(), r.Intn(3)
+		for _, c := range []FuzzyConfig{
+			{},
+			{Transpositions: true},
+			{Mode: FuzzyPrefix},
+			{Mode: FuzzyPrefix, Transpositions: true},
+		} {
+			expected := referenceFuzzy(words, query, maxEdits, c)
+			if actual := sut.FuzzySearchWithConfig(query, maxEdits, c); !reflect.DeepEqual(expected, actual) {
+				t.Fatalf("query '%s' with '%d' edits and %+v\nexpected\n%#v\ngot\n%#v\n", query, maxEdits, c, expected, actual)
+			}
+		}
+	}
+}
+
+// referenceFuzzy compares 'query' with each of 'words', or with every prefix of them for FuzzyPrefix
+func referenceFuzzy(words []string, query string, maxEdits int, c FuzzyConfig) []FuzzyMatch {
+	matches := []FuzzyMatch{}
+	for _, w := range words {
+		letters := []rune(w)
+		distance := editDistance([]rune(query), letters, c.Transpositions)
+		if c.Mode == FuzzyPrefix {
+			for i := range letters {
+				distance = min(distance, editDistance([]rune(query), letters[:i], c.Transpositions))
+			}
+		}
+		if distance <= maxEdits {
+			matches = append(matches, FuzzyMatch{Key: w, Distance: distance})
+		}
+	}
+	slices.SortFunc(matches, func(a, b FuzzyMatch) int {
+		if a.Distance != b.Distance {
+			return a.Distance - b.Distance
+		}
+		if a.Key < b.Key {
+			return -1
+		}
+		return 1
+	})
+	return matches
+}
+
+// editDistance fills the whole table of the optimal string alignment or Levenshtein distance
+func editDistance(a, b []rune, transpositions bool) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if transpositions && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
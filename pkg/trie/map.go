@@ -0,0 +1,208 @@
+package trie
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"unicode/utf8"
+)
+
+// MapNode represents a node of a Map. Only the Letter on the edge from its' parent is stored, the key of a node is
+// rebuilt from the letters along the path while traversing, or with Key. Children is a slice sorted by Letter
+// rather than a map, as most nodes have one or two children. Value and Weight are the value and rank stored for
+// the key when CompletesString is set
+type MapNode[V any] struct {
+	Children        []*MapNode[V]
+	Letter          rune
+	CompletesString bool
+	Value           V
+	Weight          int
+	parent          *MapNode[V]
+	best            []*MapNode[V] // highest ranked nodes completing a key at or below this one, see TopK
+}
+
+// Map is a Trie which associates a value with every key, such as a frequency or a list of document IDs per word.
+// Keys are split into runes like the words of a Trie, so they are normalized to valid UTF-8: each byte which is
+// not part of a valid rune is stored as utf8.RuneError (U+FFFD), and the keys yielded back hold that rune instead
+type Map[V any] struct {
+	RootNode *MapNode[V]
+	length   int
+	topK     int  // number of completions cached per node for TopK
+	cached   bool // whether the completions are cached, which they are from the first weight set
+}
+
+// NewMap creates an empty instance of Map with a root node, caching DefaultTopK completions per node for TopK once
+// a weight is set
+func NewMap[V any]() *Map[V] {
+	return NewMapWithTopK[V](DefaultTopK)
+}
+
+// NewMapWithTopK creates an empty instance of Map caching the 'k' highest ranked completions per node, so TopK
+// answers quickly for up to 'k' completions. The cache is built by the first PutWeighted or SetWeight, so a Map
+// whose keys are never weighted does not pay for it. Nothing is cached when 'k' is zero or less
+func NewMapWithTopK[V any](k int) *Map[V] {
+	return &Map[V]{
+		RootNode: &MapNode[V]{},
+		topK:     max(k, 0),
+	}
+}
+
+// Child returns the child of the node on the edge for 'letter', or nil when there is none
+func (n *MapNode[V]) Child(letter rune) *MapNode[V] {
+	if i, ok := n.childIndex(letter); ok {
+		return n.Children[i]
+	}
+	return nil
+}
+
+// Key returns the key spelled by the letters on the path from the root to the node
+func (n *MapNode[V]) Key() string {
+	letters := []rune{}
+	for ; n.parent != nil; n = n.parent {
+		letters = append(letters, n.Letter)
+	}
+	slices.Reverse(letters)
+	return string(letters)
+}
+
+// childIndex returns the index of the child for 'letter' and true, or the index it would be inserted at and false
+func (n *MapNode[V]) childIndex(letter rune) (int, bool) {
+	return slices.BinarySearchFunc(n.Children, letter, func(c *MapNode[V], letter rune) int {
+		return cmp.Compare(c.Letter, letter)
+	})
+}
+
+// Put stores 'value' for 'key', replacing any value already stored for it. The Weight of an existing key is kept
+// and a new key is given a Weight of zero
+func (m *Map[V]) Put(key string, value V) {
+	path := []*MapNode[V]{m.RootNode}
+	node := m.RootNode
+	for _, currentLetter := range key {
+		i, ok := node.childIndex(currentLetter)
+		if !ok {
+			node.Children = slices.Insert(node.Children, i, &MapNode[V]{Letter: currentLetter, parent: node})
+		}
+		node = node.Children[i]
+		path = append(path, node)
+	}
+	node.Value = value
+	if node.CompletesString {
+		return
+	}
+	m.length++
+	node.CompletesString = true
+	node.Weight = 0
+	m.promote(path)
+}
+
+// Get returns the value stored for 'key' and true, or the zero value and false when there is none
+func (m *Map[V]) Get(key string) (V, bool) {
+	node := m.find(key)
+	if node == nil || !node.CompletesString {
+		var zero V
+		return zero, false
+	}
+	return node.Value, true
+}
+
+// Delete removes 'key' and its' value, reporting whether it was stored. The node completing the key is unmarked,
+// so keys it is a prefix of remain, and only the nodes no longer leading to any key are pruned
+func (m *Map[V]) Delete(key string) bool {
+	letters := []rune(key)
+	path := m.path(key)
+	if path == nil || !path[len(path)-1].CompletesString {
+		return false
+	}
+	node := path[len(path)-1]
+	removed := node
+	node.CompletesString = false
+	var zero V
+	node.Value = zero
+	node.Weight = 0
+	m.length--
+
+	// work backwards from the end of 'key' removing each node which neither completes a key nor has children
+	for i := len(letters) - 1; i >= 0; i-- {
+		if node.CompletesString || len(node.Children) > 0 {
+			break
+		}
+		j, _ := path[i].childIndex(letters[i])
+		path[i].Children = slices.Delete(path[i].Children, j, j+1)
+		path = path[:i+1]
+		node = path[i]
+	}
+	m.demote(path, removed)
+
+	return true
+}
+
+// Len returns the number of keys stored in the Map
+func (m *Map[V]) Len() int {
+	return m.length
+}
+
+// WithPrefix returns an iterator over every key starting with 'prefix' and its' value, in lexicographic order of
+// the runes of the keys. The prefix itself is included when it is a key
+func (m *Map[V]) WithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if node := m.find(prefix); node != nil {
+			walk(node, []byte(prefix), yield)
+		}
+	}
+}
+
+// Search given a prefix string will suggest every key in the trie which starts with it, returning the remainder
+// of each key after the prefix. When the prefix is itself a key it is included as the empty suffix. Suggestions
+// are in lexicographic order, and at most 'limit' are returned unless 'limit' is zero or less.
+func (m *Map[V]) Search(prefix string, limit int) []string {
+	possibleSuffixes := []string{}
+	for key := range m.WithPrefix(prefix) {
+		possibleSuffixes = append(possibleSuffixes, key[len(prefix):])
+		if limit > 0 && len(possibleSuffixes) >= limit {
+			break
+		}
+	}
+	return possibleSuffixes
+}
+
+// find returns the node reached by following the runes of 'key' from the root, or nil when there is none
+func (m *Map[V]) find(key string) *MapNode[V] {
+	node := m.RootNode
+	for _, currentLetter := range key {
+		if node = node.Child(currentLetter); node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// path returns the nodes from the root to the node completing 'key', so 'path[i]' is the parent of the node for
+// the rune at index 'i' of 'key', or nil when there is no such node
+func (m *Map[V]) path(key string) []*MapNode[V] {
+	node := m.RootNode
+	path := []*MapNode[V]{node}
+	for _, currentLetter := range key {
+		if node = node.Child(currentLetter); node == nil {
+			return nil
+		}
+		path = append(path, node)
+	}
+	return path
+}
+
+// walk yields every key completed at or below 'node', whose key is held in 'key', depth first so the keys are in
+// lexicographic order, and returns false once 'yield' asks to stop. The key of each child is built by appending
+// its' letter to 'key', reusing the bytes of the parent
+func walk[V any](node *MapNode[V], key []byte, yield func(string, V) bool) bool {
+	if node.CompletesString && !yield(string(key), node.Value) {
+		return false
+	}
+
+	for _, childNode := range node.Children {
+		if !walk(childNode, utf8.AppendRune(key, childNode.Letter), yield) {
+			return false
+		}
+	}
+
+	return true
+}
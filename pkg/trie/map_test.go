@@ -0,0 +1,139 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMap_Put_Get_Replace(t *testing.T) {
+	sut := NewMap[int]()
+	sut.Put("the", 1)
+	sut.Put("then", 2)
+	sut.Put("the", 3)
+
+	if v, ok := sut.Get("the"); !ok || v != 3 {
+		t.Errorf("expected '3' got '%d' (ok: %v)", v, ok)
+	}
+	if v, ok := sut.Get("then"); !ok || v != 2 {
+		t.Errorf("expected '2' got '%d' (ok: %v)", v, ok)
+	}
+	for _, key := range []string{"th", "thens", "missing"} {
+		if _, ok := sut.Get(key); ok {
+			t.Errorf("expected '%s' to NOT be found", key)
+		}
+	}
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+func TestMap_Empty_Key_Is_Stored_At_Root(t *testing.T) {
+	sut := NewMap[string]()
+	sut.Put("", "root")
+	sut.Put("a", "leaf")
+
+	if v, ok := sut.Get(""); !ok || v != "root" {
+		t.Errorf("expected 'root' got '%s' (ok: %v)", v, ok)
+	}
+	if !sut.Delete("") || sut.Len() != 1 {
+		t.Errorf("expected to delete the empty key leaving '1' got '%d'", sut.Len())
+	}
+	if v, ok := sut.Get("a"); !ok || v != "leaf" {
+		t.Errorf("expected 'leaf' got '%s' (ok: %v)", v, ok)
+	}
+}
+
+func TestMap_Invalid_UTF8_Is_Stored_As_RuneError(t *testing.T) {
+	sut := NewMap[int]()
+	sut.Put("a\xffb", 1)
+
+	for _, key := range []string{"a\xffb", "a\uFFFDb"} {
+		if v, ok := sut.Get(key); !ok || v != 1 {
+			t.Errorf("expected '%q' to be found with '1' got '%d' (ok: %v)", key, v, ok)
+		}
+	}
+	keys := []string{}
+	for key := range sut.WithPrefix("") {
+		keys = append(keys, key)
+	}
+	if expected := []string{"a\uFFFDb"}; !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected '%q' got '%q'", expected, keys)
+	}
+}
+
+func TestMap_Delete_Keeps_Longer_Keys_And_Prunes(t *testing.T) {
+	sut := NewMap[[]int]()
+	sut.Put("app", []int{1})
+	sut.Put("apple", []int{1, 2})
+
+	if !sut.Delete("app") {
+		t.Errorf("expected 'app' to be deleted")
+	}
+	if sut.Delete("app") || sut.Delete("ap") {
+		t.Errorf("expected deleting missing keys to return 'false'")
+	}
+	if v, ok := sut.Get("apple"); !ok || !reflect.DeepEqual([]int{1, 2}, v) {
+		t.Errorf("expected '%v' got '%v' (ok: %v)", []int{1, 2}, v, ok)
+	}
+	if !sut.Delete("apple") || len(sut.RootNode.Children) != 0 || sut.Len() != 0 {
+		t.Errorf("expected every node to be pruned got '%+v' with length '%d'", sut.RootNode.Children, sut.Len())
+	}
+}
+
+func TestMap_WithPrefix_Yields_Keys_And_Values_In_Order(t *testing.T) {
+	sut := NewMap[int]()
+	for i, key := range []string{"tea", "ten", "to", "t", "tee", "a"} {
+		sut.Put(key, i)
+	}
+
+	keys, values := []string{}, []int{}
+	for k, v := range sut.WithPrefix("t") {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if expected := []string{"t", "tea", "tee", "ten", "to"}; !reflect.DeepEqual(expected, keys) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, keys)
+	}
+	if expected := []int{3, 0, 4, 1, 2}; !reflect.DeepEqual(expected, values) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, values)
+	}
+
+	for range sut.WithPrefix("x") {
+		t.Errorf("expected no keys for a missing prefix")
+	}
+
+	count := 0
+	for range sut.WithPrefix("") {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("expected iteration to stop at '2' got '%d'", count)
+	}
+}
+
+func TestMapNode_Children_Are_Sorted_And_Rebuild_Keys(t *testing.T) {
+	sut := NewMap[int]()
+	for i, key := range []string{"zoo", "été", "apple", "mango", "ape"} {
+		sut.Put(key, i)
+	}
+
+	letters := []rune{}
+	for _, n := range sut.RootNode.Children {
+		letters = append(letters, n.Letter)
+	}
+	if expected := []rune("amzé"); !reflect.DeepEqual(expected, letters) {
+		t.Errorf("expected '%s' got '%s'", string(expected), string(letters))
+	}
+	if n := sut.RootNode.Child('é').Child('t').Child('é'); n == nil || n.Key() != "été" || n.Value != 1 {
+		t.Errorf("expected node for 'été' got '%+v'", n)
+	}
+	if n := sut.RootNode.Child('b'); n != nil {
+		t.Errorf("expected no child for 'b' got '%+v'", n)
+	}
+	if key := sut.RootNode.Key(); key != "" {
+		t.Errorf("expected the root to have an empty key got '%s'", key)
+	}
+}
@@ -0,0 +1,199 @@
+package trie
+
+import (
+	"iter"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// patternKind is the kind of a single element of a pattern given to Match
+type patternKind int
+
+const (
+	literal patternKind = iota
+	anyRune
+	anyRunes
+	runeClass
+)
+
+// patternToken is an element of a pattern given to Match; 'ranges' holds the inclusive bounds of a runeClass in
+// pairs
+type patternToken struct {
+	kind    patternKind
+	letter  rune
+	ranges  []rune
+	negated bool
+}
+
+// Match returns an iterator over every key matched entirely by 'pattern', and its' value, in lexicographic order.
+// In the pattern '?' matches any single rune, '*' any sequence of runes including none, and '[abc]' any one of
+// the runes listed; classes may hold ranges such as '[a-z]' and are negated by a leading '!' or '^'. A '\'
+// matches the rune following it literally. ErrBadPattern is returned when the pattern is malformed
+func (m *Map[V]) Match(pattern string) (iter.Seq2[string, V], error) {
+	tokens, err := parsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(string, V) bool) {
+		start := make([]bool, len(tokens)+1)
+		start[0] = true
+		matchRecur(m.RootNode, nil, tokens, closeStates(tokens, start), yield)
+	}, nil
+}
+
+// MatchRegexp returns an iterator over every key matched entirely by 're', as if it were anchored with '^' and
+// '$', and its' value, in lexicographic order. Only the keys starting with the literal prefix of 're' are visited
+func (m *Map[V]) MatchRegexp(re *regexp.Regexp) iter.Seq2[string, V] {
+	anchored, prefix := anchorRegexp(re)
+
+	return func(yield func(string, V) bool) {
+		for key, value := range m.WithPrefix(prefix) {
+			if anchored.MatchString(key) && !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// anchorRegexp returns 're' anchored to match whole keys, and the literal prefix of every key it matches. The
+// prefix is taken from 're' itself, as LiteralPrefix reports none for most anchored expressions
+func anchorRegexp(re *regexp.Regexp) (*regexp.Regexp, string) {
+	prefix, _ := re.LiteralPrefix()
+	return regexp.MustCompile(`^(?:` + re.String() + `)$`), prefix
+}
+
+// matchRecur yields the keys at or below 'node', whose key is held in 'key', whose remaining runes can take the
+// pattern from one of the positions set in 'states' to its' end, returning false once 'yield' asks to stop.
+// Following every position at once visits each node a single time however many '*' the pattern holds
+func matchRecur[V any](node *MapNode[V], key []byte, tokens []patternToken, states []bool,
+	yield func(string, V) bool) bool {
+	if node.CompletesString && states[len(tokens)] && !yield(string(key), node.Value) {
+		return false
+	}
+
+	for _, childNode := range node.Children {
+		letter := childNode.Letter
+		next := make([]bool, len(states))
+		matched := false
+		for i, ok := range states[:len(tokens)] {
+			if !ok || !tokens[i].matches(letter) {
+				continue
+			}
+			matched = true
+			if tokens[i].kind == anyRunes {
+				next[i] = true
+			} else {
+				next[i+1] = true
+			}
+		}
+		if matched && !matchRecur(childNode, utf8.AppendRune(key, letter), tokens, closeStates(tokens, next), yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// closeStates also sets the position after every '*' which is set, as '*' may match no runes
+func closeStates(tokens []patternToken, states []bool) []bool {
+	for i, token := range tokens {
+		if states[i] && token.kind == anyRunes {
+			states[i+1] = true
+		}
+	}
+	return states
+}
+
+func (t patternToken) matches(letter rune) bool {
+	switch t.kind {
+	case literal:
+		return t.letter == letter
+	case runeClass:
+		for i := 0; i < len(t.ranges); i += 2 {
+			if t.ranges[i] <= letter && letter <= t.ranges[i+1] {
+				return !t.negated
+			}
+		}
+		return t.negated
+	default:
+		return true
+	}
+}
+
+// parsePattern splits 'pattern' into tokens, merging consecutive '*' into one
+func parsePattern(pattern string) ([]patternToken, error) {
+	letters := []rune(pattern)
+	tokens := []patternToken{}
+	for i := 0; i < len(letters); i++ {
+		switch letters[i] {
+		case '?':
+			tokens = append(tokens, patternToken{kind: anyRune})
+		case '*':
+			if len(tokens) == 0 || tokens[len(tokens)-1].kind != anyRunes {
+				tokens = append(tokens, patternToken{kind: anyRunes})
+			}
+		case '\\':
+			if i++; i == len(letters) {
+				return nil, errors.Wrapf(ErrBadPattern, "trailing '\\' in '%s'", pattern)
+			}
+			tokens = append(tokens, patternToken{kind: literal, letter: letters[i]})
+		case '[':
+			token, end, err := parseClass(letters, i+1)
+			if err != nil {
+				return nil, errors.Wrapf(err, "class at %d in '%s'", i, pattern)
+			}
+			tokens = append(tokens, token)
+			i = end
+		default:
+			tokens = append(tokens, patternToken{kind: literal, letter: letters[i]})
+		}
+	}
+	return tokens, nil
+}
+
+// parseClass reads the runes of a class starting after its' '[' at index 'i', returning the token and the index
+// of the closing ']'
+func parseClass(letters []rune, i int) (patternToken, int, error) {
+	token := patternToken{kind: runeClass}
+	if i < len(letters) && (letters[i] == '!' || letters[i] == '^') {
+		token.negated = true
+		i++
+	}
+
+	// read a rune of the class, unescaping it when it follows a '\'
+	next := func() (rune, error) {
+		if i < len(letters) && letters[i] == '\\' {
+			i++
+		}
+		if i >= len(letters) {
+			return 0, errors.Wrap(ErrBadPattern, "missing ']'")
+		}
+		i++
+		return letters[i-1], nil
+	}
+
+	for i >= len(letters) || letters[i] != ']' {
+		lo, err := next()
+		if err != nil {
+			return token, i, err
+		}
+		hi := lo
+		if i+1 < len(letters) && letters[i] == '-' && letters[i+1] != ']' {
+			i++
+			if hi, err = next(); err != nil {
+				return token, i, err
+			}
+			if hi < lo {
+				return token, i, errors.Wrapf(ErrBadPattern, "range '%c-%c' is reversed", lo, hi)
+			}
+		}
+		token.ranges = append(token.ranges, lo, hi)
+	}
+	if len(token.ranges) == 0 {
+		return token, i, errors.Wrap(ErrBadPattern, "empty class")
+	}
+	return token, i, nil
+}
@@ -0,0 +1,151 @@
+package trie
+
+import (
+	"math/rand"
+	"path"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestMatch_Wildcards_And_Classes(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"cat", "cot", "cut", "coat", "ape", "apple", "apse", "café", "c?t", "ca"} {
+		sut.Insert(w)
+	}
+
+	for pattern, expected := range map[string][]string{
+		"c?t":     {"c?t", "cat", "cot", "cut"},
+		"ap*e":    {"ape", "apple", "apse"},
+		"c[ao]t":  {"cat", "cot"},
+		"c[!ao]t": {"c?t", "cut"},
+		"c[a-o]*": {"ca", "café", "cat", "coat", "cot"},
+		"caf?":    {"café"},
+		`c\?t`:    {"c?t"},
+		"*":       {"ape", "apple", "apse", "c?t", "ca", "café", "cat", "coat", "cot", "cut"},
+		"**t":     {"c?t", "cat", "coat", "cot", "cut"},
+		"x*":      {},
+	} {
+		seq, err := sut.Match(pattern)
+		if err != nil {
+			t.Fatalf("expected '%v' for '%s' got '%v'", nil, pattern, err)
+		}
+		actual := []string{}
+		for w := range seq {
+			actual = append(actual, w)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("pattern '%s'\nexpected\n%#v\ngot\n%#v\n", pattern, expected, actual)
+		}
+	}
+}
+
+func TestMatch_Bad_Pattern_Is_An_Error(t *testing.T) {
+	sut := NewTrie()
+	for _, pattern := range []string{"[abc", "ab\\", "[]", "[z-a]", "[a-"} {
+		if _, err := sut.Match(pattern); errors.Cause(err) != ErrBadPattern {
+			t.Errorf("expected '%v' for '%s' got '%v'", ErrBadPattern, pattern, err)
+		}
+	}
+}
+
+func TestMatch_Stops_When_Asked(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"a", "ab", "abc"} {
+		sut.Insert(w)
+	}
+
+	seq, _ := sut.Match("a*")
+	count := 0
+	for range seq {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("expected '1' got '%d'", count)
+	}
+}
+
+func TestMatch_Agrees_With_Path_Match_For_Random_Patterns(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomString := func(alphabet string, n int) string {
+		letters := []rune(alphabet)
+		w := make([]rune, r.Intn(n))
+		for i := range w {
+			w[i] = letters[r.Intn(len(letters))]
+		}
+		return string(w)
+	}
+
+	sut := NewMap[struct{}]()
+	words := map[string]bool{}
+	for i := 0; i < 300; i++ {
+		w := randomString("abé", 6)
+		sut.Put(w, struct{}{})
+		words[w] = true
+	}
+
+	for i := 0; i < 300; i++ {
+		pattern := randomString("abé?*", 5)
+		if r.Intn(3) == 0 {
+			pattern += "[aé]"
+		}
+		seq, err := sut.Match(pattern)
+		if err != nil {
+			t.Fatalf("expected '%v' for '%s' got '%v'", nil, pattern, err)
+		}
+		actual := map[string]bool{}
+		for w := range seq {
+			actual[w] = true
+		}
+		for w := range words {
+			if expected, _ := path.Match(pattern, w); expected != actual[w] {
+				t.Fatalf("pattern '%s' and word '%s' expected '%v' got '%v'", pattern, w, expected, actual[w])
+			}
+		}
+	}
+}
+
+func TestMatchRegexp_Matches_Whole_Keys(t *testing.T) {
+	sut := NewMap[int]()
+	for i, w := range []string{"cat", "cart", "scat", "cats", "dog"} {
+		sut.Put(w, i)
+	}
+
+	for expr, expected := range map[string][]string{
+		"ca.*t":     {"cart", "cat"},
+		"cats?":     {"cat", "cats"},
+		"(?i)CAT":   {"cat"},
+		".*cat":     {"cat", "scat"},
+		"dog|cart":  {"cart", "dog"},
+		"cat|^scat": {"cat", "scat"},
+	} {
+		actual := []string{}
+		for w, v := range sut.MatchRegexp(regexp.MustCompile(expr)) {
+			if stored, _ := sut.Get(w); stored != v {
+				t.Errorf("expected value '%d' for '%s' got '%d'", stored, w, v)
+			}
+			actual = append(actual, w)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("regexp '%s'\nexpected\n%#v\ngot\n%#v\n", expr, expected, actual)
+		}
+	}
+}
+
+func TestMatchRegexp_Walks_From_Literal_Prefix(t *testing.T) {
+	for expr, expected := range map[string]string{
+		"ca.*t":    "ca",
+		"ap.*e":    "ap",
+		"ap*e":     "a",
+		"app.*e.*": "app",
+		"(?i)CAT":  "",
+		"dog|cart": "",
+	} {
+		if _, prefix := anchorRegexp(regexp.MustCompile(expr)); prefix != expected {
+			t.Errorf("regexp '%s' expected prefix '%s' got '%s'", expr, expected, prefix)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package trie
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// RadixNode represents a node of a RadixTree. Label holds the runes on the edge from its' parent, so a chain of
+// nodes with a single child in a Trie is a single RadixNode. Children are sorted by the first rune of their' Label
+type RadixNode struct {
+	Label           string
+	Children        []*RadixNode
+	CompletesString bool
+}
+
+// RadixTree is a compressed Trie, also known as a Patricia tree, in which every node either completes a word or
+// has at least two children. It has the same surface as Trie while allocating far fewer nodes
+type RadixTree struct {
+	RootNode *RadixNode
+	length   int
+}
+
+// NewRadixTree creates an instance of RadixTree with a root node
+func NewRadixTree() *RadixTree {
+	return &RadixTree{
+		RootNode: &RadixNode{},
+	}
+}
+
+// Insert adds a word in the RadixTree structure, ignoring leading and trailing white space
+func (t *RadixTree) Insert(word string) {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
+		return
+	}
+
+	node := t.RootNode
+	for len(w) > 0 {
+		i, ok := node.child(w)
+		if !ok {
+			node.Children = slices.Insert(node.Children, i, &RadixNode{Label: w, CompletesString: true})
+			t.length++
+			return
+		}
+
+		childNode := node.Children[i]
+		common := commonPrefix(childNode.Label, w)
+		if common < len(childNode.Label) {
+			// split the edge where 'w' leaves it, the new node taking the shared runes
+			childNode = &RadixNode{
+				Label:    childNode.Label[:common],
+				Children: []*RadixNode{childNode},
+			}
+			childNode.Children[0].Label = childNode.Children[0].Label[common:]
+			node.Children[i] = childNode
+		}
+		node = childNode
+		w = w[common:]
+	}
+
+	if !node.CompletesString {
+		t.length++
+	}
+	node.CompletesString = true
+}
+
+// Exists returns a boolean indicating that the word exists in the RadixTree
+func (t *RadixTree) Exists(word string) bool {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
+		return false
+	}
+	path := t.path(w)
+	return path != nil && path[len(path)-1].CompletesString
+}
+
+// Search given a prefix string will suggest every word in the tree which starts with it, returning the remainder
+// of each word after the prefix like Trie.Search. Suggestions are in lexicographic order, and at most 'limit' are
+// returned unless 'limit' is zero or less.
+func (t *RadixTree) Search(prefix string, limit int) []string {
+	possibleSuffixes := []string{}
+
+	// walk down while the prefix covers whole labels, then take the node whose label the prefix ends within
+	node, word, rest := t.RootNode, "", prefix
+	for len(rest) > 0 {
+		i, ok := node.child(rest)
+		if !ok {
+			return possibleSuffixes
+		}
+		childNode := node.Children[i]
+		if !strings.HasPrefix(childNode.Label, rest) && !strings.HasPrefix(rest, childNode.Label) {
+			return possibleSuffixes
+		}
+		node = childNode
+		word += childNode.Label
+		rest = rest[min(len(rest), len(childNode.Label)):]
+	}
+
+	var searchRecur func(node *RadixNode, word string) bool
+	searchRecur = func(node *RadixNode, word string) bool {
+		if node.CompletesString {
+			possibleSuffixes = append(possibleSuffixes, word[len(prefix):])
+			if limit > 0 && len(possibleSuffixes) >= limit {
+				return false
+			}
+		}
+		for _, childNode := range node.Children {
+			if !searchRecur(childNode, word+childNode.Label) {
+				return false
+			}
+		}
+		return true
+	}
+	searchRecur(node, word)
+
+	return possibleSuffixes
+}
+
+// Remove deletes a word from the RadixTree structure, returning true once it has been removed. Nodes left without
+// a word or children are removed, and a node left with a single child is merged with it. ErrNotFound is returned
+// when the word was never inserted
+func (t *RadixTree) Remove(word string) (bool, error) {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
+		return false, errors.Wrap(ErrNotFound, "empty word")
+	}
+	path := t.path(w)
+	if path == nil || !path[len(path)-1].CompletesString {
+		return false, errors.Wrapf(ErrNotFound, "remove '%s'", w)
+	}
+
+	node := path[len(path)-1]
+	node.CompletesString = false
+	t.length--
+
+	if len(node.Children) == 0 {
+		parent := path[len(path)-2]
+		i, _ := parent.child(node.Label)
+		parent.Children = slices.Delete(parent.Children, i, i+1)
+		node = parent
+	}
+	if node != t.RootNode && !node.CompletesString && len(node.Children) == 1 {
+		childNode := node.Children[0]
+		node.Label += childNode.Label
+		node.Children = childNode.Children
+		node.CompletesString = childNode.CompletesString
+	}
+
+	return true, nil
+}
+
+// Len returns the number of words in the RadixTree
+func (t *RadixTree) Len() int {
+	return t.length
+}
+
+// path returns the nodes from the root to the node whose labels spell exactly 'word', or nil when there is none
+func (t *RadixTree) path(word string) []*RadixNode {
+	node := t.RootNode
+	path := []*RadixNode{node}
+	for len(word) > 0 {
+		i, ok := node.child(word)
+		if !ok || !strings.HasPrefix(word, node.Children[i].Label) {
+			return nil
+		}
+		node = node.Children[i]
+		path = append(path, node)
+		word = word[len(node.Label):]
+	}
+	return path
+}
+
+// child returns the index of the child whose Label starts with the first rune of 's' and true, or the index it
+// would be inserted at and false
+func (n *RadixNode) child(s string) (int, bool) {
+	letter, _ := utf8.DecodeRuneInString(s)
+	return slices.BinarySearchFunc(n.Children, letter, func(c *RadixNode, letter rune) int {
+		first, _ := utf8.DecodeRuneInString(c.Label)
+		return cmp.Compare(first, letter)
+	})
+}
+
+// commonPrefix returns the length in bytes of the longest common prefix of 'a' and 'b' made of whole runes
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	// back off to the start of a rune when the strings differ part way through one
+	for i > 0 && i < len(a) && !utf8.RuneStart(a[i]) {
+		i--
+	}
+	return i
+}
@@ -0,0 +1,251 @@
+package trie
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"reflect"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestRadixTree_Insert_Splits_Edges(t *testing.T) {
+	sut := NewRadixTree()
+	for _, w := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom"} {
+		sut.Insert(w)
+	}
+
+	labels := []string{}
+	for _, n := range sut.RootNode.Children[0].Children {
+		labels = append(labels, n.Label)
+	}
+	if sut.RootNode.Children[0].Label != "r" || !reflect.DeepEqual([]string{"om", "ub"}, labels) {
+		t.Errorf("expected 'r' with children '[om ub]' got '%s' with '%v'", sut.RootNode.Children[0].Label, labels)
+	}
+	for _, w := range []string{"rom", "romane", "rubicundus"} {
+		if !sut.Exists(w) {
+			t.Errorf("expected '%s' to be found after Insert", w)
+		}
+	}
+	for _, w := range []string{"r", "ro", "roman", "rubicundusx", "", "x"} {
+		if sut.Exists(w) {
+			t.Errorf("expected '%s' to NOT be found", w)
+		}
+	}
+	if sut.Len() != 8 {
+		t.Errorf("expected '8' got '%d'", sut.Len())
+	}
+}
+
+func TestRadixTree_Search_Prefix_Ending_Within_A_Label(t *testing.T) {
+	sut := NewRadixTree()
+	for _, w := range []string{"naïve", "naïveté", "nacho", "nab"} {
+		sut.Insert(w)
+	}
+
+	for prefix, expected := range map[string][]string{
+		"naï":   {"ve", "veté"},
+		"na":    {"b", "cho", "ïve", "ïveté"},
+		"naïve": {"", "té"},
+		"nx":    {},
+		"naïx":  {},
+	} {
+		if actual := sut.Search(prefix, 0); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("prefix '%s'\nexpected\n%#v\ngot\n%#v\n", prefix, expected, actual)
+		}
+	}
+	if actual := sut.Search("na", 2); !reflect.DeepEqual([]string{"b", "cho"}, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", []string{"b", "cho"}, actual)
+	}
+}
+
+func TestRadixTree_Remove_Merges_Single_Children(t *testing.T) {
+	sut := NewRadixTree()
+	sut.Insert("test")
+	sut.Insert("testing")
+	sut.Insert("team")
+
+	if removed, err := sut.Remove("test"); !removed || err != nil {
+		t.Errorf("expected 'true' and '%v' got '%v' and '%v'", nil, removed, err)
+	}
+	if removed, err := sut.Remove("tes"); removed || errors.Cause(err) != ErrNotFound {
+		t.Errorf("expected 'false' and '%v' got '%v' and '%v'", ErrNotFound, removed, err)
+	}
+	if removed, err := sut.Remove(""); removed || errors.Cause(err) != ErrNotFound {
+		t.Errorf("expected 'false' and '%v' got '%v' and '%v'", ErrNotFound, removed, err)
+	}
+	sut.Remove("team")
+
+	if n := sut.RootNode.Children; len(n) != 1 || n[0].Label != "testing" || len(n[0].Children) != 0 {
+		t.Errorf("expected a single node 'testing' got '%+v'", n)
+	}
+}
+
+func TestRadixTree_Matches_Trie_For_Random_Operations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	letters := []rune("abcü")
+	randomWord := func() string {
+		w := make([]rune, r.Intn(6))
+		for i := range w {
+			w[i] = letters[r.Intn(len(letters))]
+		}
+		return string(w)
+	}
+
+	sut := NewRadixTree()
+	reference := NewTrie()
+	for i := 0; i < 5000; i++ {
+		w := randomWord()
+		if r.Intn(2) == 0 {
+			sut.Insert(w)
+			reference.Insert(w)
+		} else {
+			removed, _ := sut.Remove(w)
+			if expected, _ := reference.Remove(w); removed != expected {
+				t.Fatalf("operation %d: expected removing '%s' to return '%v' got '%v'", i, w, expected, removed)
+			}
+		}
+
+		prefix := randomWord()
+		if expected, actual := reference.Search(prefix, 0), sut.Search(prefix, 0); !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("operation %d: prefix '%s'\nexpected\n%#v\ngot\n%#v\n", i, prefix, expected, actual)
+		}
+		if sut.Len() != reference.Len() {
+			t.Fatalf("operation %d: expected '%d' got '%d'", i, reference.Len(), sut.Len())
+		}
+		assertCompressed(t, sut.RootNode, true)
+	}
+}
+
+// assertCompressed checks that every node below the root has a label and either completes a word or branches
+func assertCompressed(t *testing.T, node *RadixNode, root bool) {
+	t.Helper()
+	if !root && (node.Label == "" || (!node.CompletesString && len(node.Children) < 2)) {
+		t.Fatalf("expected node '%+v' to be merged", node)
+	}
+	for _, childNode := range node.Children {
+		assertCompressed(t, childNode, false)
+	}
+}
+
+// generatedWords is the number of words loadWords generates when words.json is not present
+const generatedWords = 100000
+
+// loadWords reads the dictionary used by LoadSearch. words.json is not checked in, so when it is not present a
+// word list of the same shape is generated from syllables with a fixed seed, letting the benchmarks always run
+// and compare like with like
+func loadWords(b *testing.B) []string {
+	raw, err := os.ReadFile("words.json")
+	if err != nil {
+		b.Logf("words.json is not available, using %d generated words: %v", generatedWords, err)
+		return generateWords(generatedWords)
+	}
+	counts := map[string]int{}
+	if err := json.Unmarshal(raw, &counts); err != nil {
+		b.Fatal(err)
+	}
+	words := make([]string, 0, len(counts))
+	for w := range counts {
+		words = append(words, w)
+	}
+	slices.Sort(words)
+	return words
+}
+
+// generateWords returns 'n' distinct words built from one to five syllables, so that like a dictionary many
+// words share prefixes, in sorted order
+func generateWords(n int) []string {
+	syllables := []string{
+		"a", "an", "ar", "be", "ca", "con", "de", "di", "e", "en", "er", "ing", "is", "ka", "la", "li", "ly", "ma",
+		"ment", "mo", "na", "ne", "o", "or", "pa", "per", "pro", "ra", "re", "ri", "sa", "se", "ta", "te", "ter",
+		"ti", "to", "tion", "un", "ver",
+	}
+	r := rand.New(rand.NewSource(1))
+	seen := make(map[string]bool, n)
+	words := make([]string, 0, n)
+	for len(words) < n {
+		w := ""
+		for i := 1 + r.Intn(5); i > 0; i-- {
+			w += syllables[r.Intn(len(syllables))]
+		}
+		if !seen[w] {
+			seen[w] = true
+			words = append(words, w)
+		}
+	}
+	slices.Sort(words)
+	return words
+}
+
+// benchmarkLoad times 'build' and reports the bytes of heap retained by the structure it returns
+func benchmarkLoad(b *testing.B, build func() any) {
+	for i := 0; i < b.N; i++ {
+		build()
+	}
+	b.StopTimer()
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	structure := build()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(structure)
+	// subtract as signed values, the collector may free more than the structure retains, leaving a negative delta
+	b.ReportMetric(float64(int64(after.HeapAlloc)-int64(before.HeapAlloc)), "heap-bytes")
+}
+
+func BenchmarkLoad_Trie(b *testing.B) {
+	words := loadWords(b)
+	b.ResetTimer()
+	benchmarkLoad(b, func() any {
+		sut := NewTrie()
+		for _, w := range words {
+			sut.Insert(w)
+		}
+		return sut
+	})
+}
+
+func BenchmarkLoad_RadixTree(b *testing.B) {
+	words := loadWords(b)
+	b.ResetTimer()
+	benchmarkLoad(b, func() any {
+		sut := NewRadixTree()
+		for _, w := range words {
+			sut.Insert(w)
+		}
+		return sut
+	})
+}
+
+func BenchmarkSearch_Trie(b *testing.B) {
+	words := loadWords(b)
+	sut := NewTrie()
+	for _, w := range words {
+		sut.Insert(w)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := words[i%len(words)]
+		sut.Search(string([]rune(w)[:min(2, len([]rune(w)))]), 25)
+		sut.Exists(w)
+	}
+}
+
+func BenchmarkSearch_RadixTree(b *testing.B) {
+	words := loadWords(b)
+	sut := NewRadixTree()
+	for _, w := range words {
+		sut.Insert(w)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := words[i%len(words)]
+		sut.Search(string([]rune(w)[:min(2, len([]rune(w)))]), 25)
+		sut.Exists(w)
+	}
+}
@@ -0,0 +1,164 @@
+package trie
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DefaultTopK is the number of completions cached per node by NewMap and NewTrie once a weight is set
+const DefaultTopK = 10
+
+// PutWeighted stores 'value' for 'key' like Put and ranks the key with 'weight' for TopK
+func (m *Map[V]) PutWeighted(key string, value V, weight int) {
+	m.Put(key, value)
+	m.SetWeight(key, weight)
+}
+
+// SetWeight changes the rank of 'key' for TopK, reporting whether the key is stored. The first call caches the
+// completions of every node, later calls only update the cached completions of the nodes along 'key'
+func (m *Map[V]) SetWeight(key string, weight int) bool {
+	path := m.path(key)
+	if path == nil || !path[len(path)-1].CompletesString {
+		return false
+	}
+	node := path[len(path)-1]
+	previous := node.Weight
+	node.Weight = weight
+	if !m.cached && m.topK > 0 {
+		m.cache(m.RootNode)
+		m.cached = true
+		return true
+	}
+	switch {
+	case weight > previous:
+		m.promote(path)
+	case weight < previous:
+		m.demote(path, node)
+	}
+	return true
+}
+
+// TopK returns the 'k' highest weighted keys starting with 'prefix', returning the remainder of each key after the
+// prefix like Search. Keys of equal Weight are in lexicographic order. When 'k' is no more than the number cached
+// per node this takes time proportional to the length of the prefix plus 'k', otherwise every key below the prefix
+// is visited
+func (m *Map[V]) TopK(prefix string, k int) []string {
+	suggestions := []string{}
+	node := m.find(prefix)
+	if node == nil || k <= 0 {
+		return suggestions
+	}
+
+	best := node.best
+	if k > m.topK || !m.cached {
+		best = nil
+		var collect func(node *MapNode[V])
+		collect = func(node *MapNode[V]) {
+			if node.CompletesString {
+				best = append(best, node)
+			}
+			for _, childNode := range node.Children {
+				collect(childNode)
+			}
+		}
+		collect(node)
+		slices.SortFunc(best, compareRank[V])
+	}
+
+	for _, n := range best[:min(k, len(best))] {
+		suggestions = append(suggestions, n.Key()[len(prefix):])
+	}
+	return suggestions
+}
+
+// promote offers the node at the end of 'path', which has just been stored or had its' Weight raised, to the
+// cached completions of every node along 'path'
+func (m *Map[V]) promote(path []*MapNode[V]) {
+	if !m.cached {
+		return
+	}
+	node := path[len(path)-1]
+	for _, n := range path {
+		best := slices.DeleteFunc(n.best, func(b *MapNode[V]) bool { return b == node })
+		i, _ := slices.BinarySearchFunc(best, node, compareRank[V])
+		if i < m.topK {
+			best = slices.Insert(best, i, node)
+			// clear the reference dropped from the end so the node can be collected once removed
+			if len(best) > m.topK {
+				best[m.topK] = nil
+				best = best[:m.topK]
+			}
+		}
+		n.best = best
+	}
+}
+
+// demote rebuilds the cached completions of the nodes along 'path' which held 'node', after it has had its' Weight
+// lowered or been removed. The nodes are visited from the deepest, so children are rebuilt before their' parents
+func (m *Map[V]) demote(path []*MapNode[V], node *MapNode[V]) {
+	if !m.cached {
+		return
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if slices.Contains(path[i].best, node) {
+			m.rank(path[i])
+		}
+	}
+}
+
+// cache fills the cached completions of every node at or below 'node', children before their' parents
+func (m *Map[V]) cache(node *MapNode[V]) {
+	for _, childNode := range node.Children {
+		m.cache(childNode)
+	}
+	m.rank(node)
+}
+
+// rank rebuilds the cached completions of 'n' from its' own key and the cached completions of its' children
+func (m *Map[V]) rank(n *MapNode[V]) {
+	best := []*MapNode[V]{}
+	if n.CompletesString {
+		best = append(best, n)
+	}
+	for _, childNode := range n.Children {
+		best = append(best, childNode.best...)
+	}
+	slices.SortFunc(best, compareRank[V])
+	n.best = slices.Clip(best[:min(m.topK, len(best))])
+}
+
+// compareRank orders nodes by descending Weight and then by their' keys
+func compareRank[V any](a, b *MapNode[V]) int {
+	if c := cmp.Compare(b.Weight, a.Weight); c != 0 {
+		return c
+	}
+	return compareKeys(a, b)
+}
+
+// compareKeys orders nodes by their' keys without building them, comparing the letters below the deepest node
+// the two paths share. A node comes before the nodes below it
+func compareKeys[V any](a, b *MapNode[V]) int {
+	depthA, depthB := a.depth(), b.depth()
+	for ; depthA > depthB; depthA-- {
+		if a = a.parent; a == b {
+			return 1
+		}
+	}
+	for ; depthB > depthA; depthB-- {
+		if b = b.parent; b == a {
+			return -1
+		}
+	}
+	for a.parent != b.parent {
+		a, b = a.parent, b.parent
+	}
+	return cmp.Compare(a.Letter, b.Letter)
+}
+
+func (n *MapNode[V]) depth() int {
+	depth := 0
+	for ; n.parent != nil; n = n.parent {
+		depth++
+	}
+	return depth
+}
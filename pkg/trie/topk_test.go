@@ -0,0 +1,176 @@
+package trie
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTopK_Ranks_By_Weight_Then_Word(t *testing.T) {
+	sut := NewTrie()
+	sut.InsertWeighted("thaumaturgy", 1)
+	sut.InsertWeighted("the", 500)
+	sut.InsertWeighted("then", 40)
+	sut.InsertWeighted("than", 40)
+	sut.Insert("thy")
+
+	expected := []string{"e", "an", "en", "aumaturgy"}
+	actual := sut.TopK("th", 4)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+	if actual := sut.TopK("x", 4); len(actual) != 0 {
+		t.Errorf("expected no suggestions for a missing prefix got '%#v'", actual)
+	}
+	if actual := sut.TopK("th", 0); len(actual) != 0 {
+		t.Errorf("expected no suggestions for 'k' of '0' got '%#v'", actual)
+	}
+}
+
+func TestTopK_Beyond_Cache_Visits_Every_Word(t *testing.T) {
+	sut := NewMapWithTopK[struct{}](2)
+	for i, w := range []string{"a", "ab", "abc", "abd", "b"} {
+		sut.PutWeighted(w, struct{}{}, i)
+	}
+
+	expected := []string{"b", "abd", "abc", "ab"}
+	if actual := sut.TopK("", 4); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+	if actual := NewMapWithTopK[int](0).TopK("", 1); len(actual) != 0 {
+		t.Errorf("expected no suggestions from an empty Map got '%#v'", actual)
+	}
+}
+
+func TestSetWeight_Updates_Ranking(t *testing.T) {
+	sut := NewTrie()
+	for i, w := range []string{"car", "card", "care", "cart"} {
+		sut.InsertWeighted(w, i)
+	}
+
+	if !sut.SetWeight(" car", 10) || sut.SetWeight("ca", 10) {
+		t.Errorf("expected SetWeight to report 'true' for a word and 'false' for a prefix")
+	}
+	sut.SetWeight("cart", -1)
+	expected := []string{"r", "re", "rd", "rt"}
+	if actual := sut.TopK("ca", 4); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+
+	sut.Remove("car")
+	sut.Insert("care") // an existing word keeps its' weight
+	expected = []string{"re", "rd", "rt"}
+	if actual := sut.TopK("ca", 4); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+}
+
+func TestSetWeight_Reports_Whether_Key_Is_Stored(t *testing.T) {
+	sut := NewMap[int]()
+	sut.Put("car", 1)
+
+	if !sut.SetWeight("car", 10) || sut.SetWeight("ca", 10) || sut.SetWeight("cars", 10) {
+		t.Errorf("expected SetWeight to report 'true' for a key and 'false' for a prefix or missing key")
+	}
+	if actual := sut.TopK("c", 1); !reflect.DeepEqual([]string{"ar"}, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", []string{"ar"}, actual)
+	}
+}
+
+func TestTopK_Cache_Is_Built_By_First_Weight(t *testing.T) {
+	sut := NewMap[int]()
+	for i, w := range []string{"tea", "ten", "to", "toe"} {
+		sut.Put(w, i)
+	}
+	sut.Delete("ten")
+
+	var cachedNodes func(node *MapNode[int]) int
+	cachedNodes = func(node *MapNode[int]) int {
+		count := 0
+		if node.best != nil {
+			count++
+		}
+		for _, childNode := range node.Children {
+			count += cachedNodes(childNode)
+		}
+		return count
+	}
+	if count := cachedNodes(sut.RootNode); count != 0 {
+		t.Errorf("expected no cached completions without weights got '%d' nodes", count)
+	}
+	if actual := sut.TopK("t", 2); !reflect.DeepEqual([]string{"ea", "o"}, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", []string{"ea", "o"}, actual)
+	}
+
+	sut.SetWeight("toe", 5)
+	sut.PutWeighted("ten", 1, 3)
+	if count := cachedNodes(sut.RootNode); count == 0 {
+		t.Errorf("expected the completions to be cached once a weight is set")
+	}
+	if actual := sut.TopK("t", 3); !reflect.DeepEqual([]string{"oe", "en", "ea"}, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", []string{"oe", "en", "ea"}, actual)
+	}
+}
+
+func TestTopK_Matches_Reference_For_Random_Operations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	letters := []rune("abcé")
+	randomWord := func() string {
+		w := make([]rune, 1+r.Intn(4))
+		for i := range w {
+			w[i] = letters[r.Intn(len(letters))]
+		}
+		return string(w)
+	}
+
+	sut := NewMapWithTopK[int](3)
+	reference := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		w := randomWord()
+		switch r.Intn(3) {
+		case 0:
+			weight := r.Intn(20)
+			sut.PutWeighted(w, i, weight)
+			reference[w] = weight
+		case 1:
+			sut.Delete(w)
+			delete(reference, w)
+		default:
+			if _, ok := reference[w]; ok {
+				reference[w] = r.Intn(20)
+				sut.SetWeight(w, reference[w])
+			}
+		}
+
+		prefix := string([]rune(randomWord())[:r.Intn(3)])
+		k := 1 + r.Intn(3)
+		expected := referenceTopK(reference, prefix, k)
+		if actual := sut.TopK(prefix, k); !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("operation %d: TopK('%s', %d)\nexpected\n%#v\ngot\n%#v\n", i, prefix, k, expected, actual)
+		}
+	}
+}
+
+// referenceTopK ranks the words of 'reference' starting with 'prefix' by sorting all of them
+func referenceTopK(reference map[string]int, prefix string, k int) []string {
+	words := []string{}
+	for w := range reference {
+		if strings.HasPrefix(w, prefix) {
+			words = append(words, w)
+		}
+	}
+	slices.SortFunc(words, func(a, b string) int {
+		if c := cmp.Compare(reference[b], reference[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	suggestions := []string{}
+	for _, w := range words[:min(k, len(words))] {
+		suggestions = append(suggestions, w[len(prefix):])
+	}
+	return suggestions
+}
@@ -1,92 +1,74 @@
 package trie
 
 import (
-	"fmt"
-	"sort"
+	"iter"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
 )
 
 var (
-	// ErrSuffixesFound is an error value for when a word cannot be deleted because it has dependent child TrieNodes'
-	ErrSuffixesFound = errors.New("suffixes were found that prevent word removal")
+	// ErrNotFound is an error value for when a word to be removed was never inserted in the Trie
+	ErrNotFound = errors.New("word not found in trie")
+
+	// ErrBadPattern is an error value for when a pattern given to Match is malformed
+	ErrBadPattern = errors.New("syntax error in pattern")
 )
 
-var alph = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z"}
+// Node represents a node a Trie data structure. Each node holds a rune so that words containing multibyte
+// characters are split into characters rather than bytes. Combining characters are runes of their own, so "café"
+// written with a precomposed é and with e followed by a combining accent are stored as different words
+type Node = MapNode[struct{}]
 
-// Node represents a node a Trie data structure
-type Node struct {
-	Children        map[string]*Node
-	Val             string
-	CompletesString bool
+// NewNode creates an instance of TrieNode with the supplied letter on the edge from 'parent', without adding it to
+// the children of 'parent'
+func NewNode(letter rune, parent *Node) *Node {
+	return &Node{Letter: letter, parent: parent}
 }
 
-// NewNode creates an instance of TrieNode with the supplied letter for its' value
-func NewNode(letter string, parent *Node) *Node {
-	return &Node{
-		Children: make(map[string]*Node),
-		Val:      letter,
-	}
-}
-
-// Trie is a Tree like data structure which associates a prefix string with "branches" of suffixes
+// Trie is a Tree like data structure which associates a prefix string with "branches" of suffixes. It wraps a Map
+// without values, trimming the words given to it, and forwards the queries of Map such as Search and WithPrefix
 type Trie struct {
 	RootNode *Node
+	words    *Map[struct{}]
 }
 
 // NewTrie creates an instance of Trie with a root node
 func NewTrie() *Trie {
+	words := NewMap[struct{}]()
 	return &Trie{
-		RootNode: NewNode("", nil),
+		RootNode: words.RootNode,
+		words:    words,
 	}
 }
 
-// Insert adds a word in the Trie structure
+// Insert adds a word in the Trie structure, ignoring leading and trailing white space
 func (t *Trie) Insert(word string) {
 	w := strings.TrimSpace(word)
 	if len(w) == 0 {
 		return
 	}
+	t.words.Put(w, struct{}{})
+}
 
-	node := t.RootNode
-	letters := strings.Split(w, "")
-	val := "" // this will contain the parent nodes' 'value' + current node character so [w, wo, wor, word] etc.
-	for i := 0; i < len(letters); i++ {
-		currentLetter := letters[i]
-		val += currentLetter
-		if v, ok := node.Children[currentLetter]; ok {
-			node = v
-		} else {
-			newNode := NewNode(val, node)
-			node.Children[currentLetter] = newNode
-			node = newNode
-		}
+// InsertWeighted adds a word in the Trie structure like Insert, ranked with 'weight' for TopK
+func (t *Trie) InsertWeighted(word string, weight int) {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
+		return
 	}
-	node.CompletesString = true
+	t.words.PutWeighted(w, struct{}{}, weight)
 }
 
 // Exists returns a boolean indicating that the word exists in the Trie
 func (t *Trie) Exists(word string) bool {
-	word = strings.TrimSpace(word)
-	if len(word) == 0 {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
 		return false
 	}
-	node := t.RootNode
-	letters := strings.Split(word, "")
-	var result bool
-	for i := 0; i < len(letters); i++ {
-		if v, ok := node.Children[letters[i]]; ok {
-			node = v
-			if i == len(letters)-1 {
-				result = node.Val == word && node.CompletesString
-				break
-			}
-			continue
-		}
-		break
-	}
-	return result
+	_, ok := t.words.Get(w)
+	return ok
 }
 
 // FindCompletesString finds the leaf node for a word in the trie
@@ -95,114 +77,70 @@ func (t *Trie) FindCompletesString(word string) *Node {
 	if len(w) == 0 {
 		return nil
 	}
-	node := t.RootNode
-	letters := strings.Split(word, "")
-	for i := 0; i < len(letters); i++ {
-		currentLetter := letters[i]
-		if v, ok := node.Children[currentLetter]; ok {
-			node = v
-		} else {
-			return nil
-		}
-	}
-
-	return node
+	return t.words.find(w)
 }
 
-// Search given a prefix string will suggest 'nearby' words in the trie which form 'complete' dictionary words.
-func (t *Trie) Search(prefix string) []string {
-	node := t.RootNode
-	letters := strings.Split(prefix, "")
-	possibleSuffixes := &[]string{}
-
-	for i := 0; i < len(letters); i++ {
-		currentLetter := letters[i]
-		if childNode, ok := node.Children[currentLetter]; ok {
-			node = childNode
-		} else {
-			return *possibleSuffixes
-		}
+// Remove deletes a word from the Trie structure, returning true once it has been removed. The node completing the
+// word is unmarked, so words it is a prefix of remain, and only the nodes no longer leading to any word are pruned.
+// ErrNotFound is returned when the word was never inserted
+func (t *Trie) Remove(word string) (bool, error) {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
+		return false, errors.Wrap(ErrNotFound, "empty word")
 	}
-
-	// recursively walk the sub trees of child nodes of the last node processed in the 'prefix' to find the closest
-	// completesString suffixes that are contained in nearby subtrees
-	for i := 0; i < 25; i++ {
-		if v, exists := node.Children[alph[i]]; exists {
-			w := ""
-			searchRecur(prefix, w, v, possibleSuffixes)
-		}
+	if !t.words.Delete(w) {
+		return false, errors.Wrapf(ErrNotFound, "remove '%s'", w)
 	}
-
-	sort.Slice(*possibleSuffixes, func(i, j int) bool {
-		return (*possibleSuffixes)[i] < (*possibleSuffixes)[j]
-	})
-
-	return *possibleSuffixes
-
+	return true, nil
 }
 
-func searchRecur(prefix, accumulator string, node *Node, suffixes *[]string) string {
-	accumulator += node.Val
-
-	if node.CompletesString {
-		return node.Val
-	}
-
-	for _, v := range node.Children {
-		if retval := searchRecur(prefix, accumulator, v, suffixes); retval != "" {
-			val := v.Val
-			*suffixes = append(*suffixes, strings.Replace(val, prefix, "", 1))
-			accumulator = ""
-		}
-	}
-
-	return ""
+// Len returns the number of words in the Trie
+func (t *Trie) Len() int {
+	return t.words.Len()
 }
 
-// Remove returns a string value indicating the result of attempting to remove an entire word from the Trie structure
-// or an error indicating the problem encountered
-func (t *Trie) Remove(word string) (string, error) {
-	node := t.RootNode
-	suffixes := []*Node{}
+// Search suggests every word in the Trie starting with 'prefix', see Map.Search
+func (t *Trie) Search(prefix string, limit int) []string {
+	return t.words.Search(prefix, limit)
+}
 
-	letters := strings.Split(word, "")
+// WithPrefix returns an iterator over every word in the Trie starting with 'prefix', see Map.WithPrefix
+func (t *Trie) WithPrefix(prefix string) iter.Seq2[string, struct{}] {
+	return t.words.WithPrefix(prefix)
+}
 
-	// walk the trie structure for each letter of 'word', determining at the end if the word has children before proceeding
-	for i := 0; i < len(letters); i++ {
-		currentLetter := letters[i]
-		if v, ok := node.Children[currentLetter]; ok {
-			node = v
-			// add the suffixes in reverse order a.k.a. unshift so 'word' will be [d,r,o,w] <- TrieNodes
-			suffixes = append([]*Node{node}, suffixes...)
+// TopK returns the 'k' highest weighted words starting with 'prefix', see Map.TopK
+func (t *Trie) TopK(prefix string, k int) []string {
+	return t.words.TopK(prefix, k)
+}
 
-			// can we even proceed with removal ?
-			// if i == len(letters) && len(node.Children) > 0 {
-			// 	return "", ErrSuffixesFound
-			// }
-		}
+// SetWeight changes the rank of a word for TopK, ignoring leading and trailing white space, and reports whether
+// the word is in the Trie, see Map.SetWeight
+func (t *Trie) SetWeight(word string, weight int) bool {
+	w := strings.TrimSpace(word)
+	if len(w) == 0 {
+		return false
 	}
+	return t.words.SetWeight(w, weight)
+}
 
-	// for each letter in 'word' work backwards from the edge removing a trie node from trie each go
-	for j := 1; j < len(suffixes); j++ {
-		childLetter := string(word[len(suffixes)-j]) // last character in the string "word", e.g. "d"
-
-		// the node in 'suffixes' representing the parent node of the node with 'childLetter' as its key in the Node
-		parent := suffixes[j]
-
-		if childNode, exists := parent.Children[childLetter]; exists {
-			if len(childNode.Children) > 0 {
-				return "", ErrSuffixesFound
-			}
-			delete(parent.Children, childLetter)
-		}
+// FuzzySearch returns every word within 'maxEdits' of 'query', see Map.FuzzySearch
+func (t *Trie) FuzzySearch(query string, maxEdits int) []FuzzyMatch {
+	return t.words.FuzzySearch(query, maxEdits)
+}
 
-		if parent.CompletesString || len(parent.Children) > 0 {
-			return fmt.Sprintf("some suffixes of '%s', were removed from trie", word), nil
-		}
-	}
+// FuzzySearchWithConfig returns every word within 'maxEdits' of 'query' using the supplied FuzzyConfig, see
+// Map.FuzzySearchWithConfig
+func (t *Trie) FuzzySearchWithConfig(query string, maxEdits int, c FuzzyConfig) []FuzzyMatch {
+	return t.words.FuzzySearchWithConfig(query, maxEdits, c)
+}
 
-	// if we got this far, we are able to remove the root node of 'word' from the RootNode
-	delete(t.RootNode.Children, string(word[0]))
+// Match returns an iterator over every word matched entirely by 'pattern', see Map.Match
+func (t *Trie) Match(pattern string) (iter.Seq2[string, struct{}], error) {
+	return t.words.Match(pattern)
+}
 
-	return fmt.Sprintf("removed '%s'; no other '%s' = words remain", word, string(word[0])), nil
+// MatchRegexp returns an iterator over every word matched entirely by 're', see Map.MatchRegexp
+func (t *Trie) MatchRegexp(re *regexp.Regexp) iter.Seq2[string, struct{}] {
+	return t.words.MatchRegexp(re)
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/pkg/browser"
 
@@ -14,6 +15,10 @@ import (
 	"github.com/gorilla/mux"
 )
 
+// defaultSearchLimit is the number of suggestions returned when the request does not specify a 'limit', and the
+// number cached per node so those are found quickly
+const defaultSearchLimit = 25
+
 // LoadSearch starts a webserver that exposes an endpoint search over backing trie datastructure
 // with some baseline dictionary loaded
 func LoadSearch() {
@@ -38,10 +43,10 @@ func LoadSearch() {
 		panic(fmt.Sprintf("error unmarshalling words\n%v", err))
 	}
 
-	// create trie for holding the words and insert values
-	trie := NewTrie()
-	for k := range words {
-		trie.Insert(k)
+	// create trie for holding the words along with their' counts, ranking the suggestions by count
+	trie := NewMapWithTopK[int](defaultSearchLimit)
+	for k, count := range words {
+		trie.PutWeighted(k, count, count)
 	}
 	fmt.Println("finished inserting all words")
 
@@ -49,7 +54,11 @@ func LoadSearch() {
 	router.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		queryParams := r.URL.Query()
 		word := queryParams.Get("search")
-		suggestions := trie.Search(word)
+		limit, err := strconv.Atoi(queryParams.Get("limit"))
+		if err != nil {
+			limit = defaultSearchLimit
+		}
+		suggestions := trie.TopK(word, limit)
 
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 
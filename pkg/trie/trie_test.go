@@ -3,8 +3,8 @@ package trie
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"reflect"
-	"sort"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -82,46 +82,60 @@ func TestRemove_Word_Removed_Can_Not_Be_Found(t *testing.T) {
 	if !sut.Exists(word) {
 		t.Errorf("expected '%s' to be found after Insert but 'false' returned from Find operation", word)
 	}
-	_, err := sut.Remove(word)
-	if err != nil {
-		t.Errorf("expected %v, got %v", nil, err)
+	removed, err := sut.Remove(word)
+	if !removed || err != nil {
+		t.Errorf("expected 'true' and '%v', got '%v' and '%v'", nil, removed, err)
 	}
 	if sut.Exists(word) {
 		t.Errorf("expected '%s' to NOT be found after Remove but 'true' returned from Find operation", word)
 	}
+	if len(sut.RootNode.Children) != 0 {
+		t.Errorf("expected every node of '%s' to be pruned got '%+v'", word, sut.RootNode.Children)
+	}
 }
 
-func TestRemove_Word_Can_Not_Be_Removed_SuffixesFound_Error(t *testing.T) {
+func TestRemove_Prefix_Of_Another_Word_Is_Not_Found_Error(t *testing.T) {
 	sut := NewTrie()
 	sut.Insert("test")
 	sut.Insert("testing")
-	mssg, err := sut.Remove("tes")
-	if mssg != "" {
-		t.Errorf("expected '' got '%s'", mssg)
+	removed, err := sut.Remove("tes")
+	if removed {
+		t.Errorf("expected 'false' got '%v'", removed)
 	}
-	if errors.Cause(err) != ErrSuffixesFound {
-		t.Errorf("expected '%v', got '%v'", ErrSuffixesFound, errors.Cause(err))
+	if errors.Cause(err) != ErrNotFound {
+		t.Errorf("expected '%v', got '%v'", ErrNotFound, errors.Cause(err))
 	}
 }
 
-func TestRemove_Non_Existing_Word_Supplied_Is_Ignored_No_Error(t *testing.T) {
+func TestRemove_Non_Existing_Word_Is_Not_Found_Error(t *testing.T) {
 	sut := NewTrie()
-	if msg, err := sut.Remove("invalid"); err != nil {
-		t.Errorf("expected '%v', got '%v'", nil, err)
-		t.Errorf(msg)
+	for _, word := range []string{"invalid", "", "   "} {
+		if removed, err := sut.Remove(word); removed || errors.Cause(err) != ErrNotFound {
+			t.Errorf("expected 'false' and '%v' for '%s', got '%v' and '%v'", ErrNotFound, word, removed, err)
+		}
 	}
 }
 
-func TestRemove_Word_Doesnt_Allow_Removal_When_Children_Suffixes_Exist(t *testing.T) {
+func TestRemove_Word_Is_Removed_When_Children_Suffixes_Exist(t *testing.T) {
 	sut := NewTrie()
 	word := "test"
 	sut.Insert(word)
 	wordSuffix := "testing"
 	sut.Insert(wordSuffix)
 
-	_, err := sut.Remove(word)
-	if errors.Cause(err) != ErrSuffixesFound {
-		t.Errorf("expected '%v' got '%v'", ErrSuffixesFound, err)
+	removed, err := sut.Remove(word)
+	if !removed || err != nil {
+		t.Errorf("expected 'true' and '%v' got '%v' and '%v'", nil, removed, err)
+	}
+	if sut.Exists(word) {
+		t.Errorf("expected '%s' to NOT be found after Remove", word)
+	}
+	if !sut.Exists(wordSuffix) {
+		t.Errorf("expected '%s' to be found after removing its' prefix", wordSuffix)
+	}
+	if removed, err := sut.Remove(word); removed || errors.Cause(err) != ErrNotFound {
+		t.Errorf("expected removing '%s' twice to return 'false' and '%v' got '%v' and '%v'",
+			word, ErrNotFound, removed, err)
 	}
 }
 
@@ -142,6 +156,106 @@ func TestRemove_Word_Suffix_Removed_Doesnt_Affect_Parent(t *testing.T) {
 	if !sut.Exists(word) {
 		t.Errorf("expected '%s' to be found after leaf node removal", word)
 	}
+	if n := sut.FindCompletesString(word); n == nil || len(n.Children) != 0 {
+		t.Errorf("expected the nodes after '%s' to be pruned got '%+v'", word, n)
+	}
+}
+
+func TestRemove_Matches_Map_Reference_For_Every_Subset(t *testing.T) {
+	words := []string{"a", "ab", "abc", "abd", "b", "ba", "bad", "é", "éa"}
+
+	for subset := 0; subset < 1<<len(words); subset++ {
+		sut := NewTrie()
+		reference := map[string]bool{}
+		for i, w := range words {
+			if subset&(1<<i) != 0 {
+				sut.Insert(w)
+				reference[w] = true
+			}
+		}
+
+		// remove every word, inserted or not, comparing the outcome with the reference after each step
+		for _, w := range words {
+			removed, err := sut.Remove(w)
+			if removed != reference[w] || (err == nil) != reference[w] {
+				t.Fatalf("subset %b: expected removing '%s' to return '%v' got '%v' and '%v'",
+					subset, w, reference[w], removed, err)
+			}
+			delete(reference, w)
+			assertMatchesReference(t, sut, words, reference)
+		}
+	}
+}
+
+func TestRemove_Matches_Map_Reference_For_Random_Operations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	letters := []rune("abcü")
+	randomWord := func() string {
+		w := make([]rune, r.Intn(5))
+		for i := range w {
+			w[i] = letters[r.Intn(len(letters))]
+		}
+		return string(w)
+	}
+
+	sut := NewTrie()
+	reference := map[string]bool{}
+	seen := []string{}
+	for i := 0; i < 5000; i++ {
+		w := randomWord()
+		seen = append(seen, w)
+		if r.Intn(2) == 0 {
+			sut.Insert(w)
+			if w != "" {
+				reference[w] = true
+			}
+			continue
+		}
+		removed, err := sut.Remove(w)
+		if removed != reference[w] || (err == nil) != reference[w] {
+			t.Fatalf("operation %d: expected removing '%s' to return '%v' got '%v' and '%v'",
+				i, w, reference[w], removed, err)
+		}
+		delete(reference, w)
+	}
+	assertMatchesReference(t, sut, seen, reference)
+}
+
+// assertMatchesReference checks that exactly the words in 'reference' exist among 'words', and that every node
+// of 'sut' still leads to a word, so none were left behind by Remove
+func assertMatchesReference(t *testing.T, sut *Trie, words []string, reference map[string]bool) {
+	t.Helper()
+	for _, w := range words {
+		if sut.Exists(w) != reference[w] {
+			t.Fatalf("expected Exists('%s') to be '%v' with %v", w, reference[w], reference)
+		}
+	}
+	if count := countWords(sut.RootNode); count != len(reference) {
+		t.Fatalf("expected '%d' words got '%d'", len(reference), count)
+	}
+	var walk func(node *Node) bool
+	walk = func(node *Node) bool {
+		leadsToWord := node.CompletesString
+		for _, child := range node.Children {
+			leadsToWord = walk(child) || leadsToWord
+		}
+		if !leadsToWord && node != sut.RootNode {
+			t.Fatalf("expected node '%s' to be pruned", node.Key())
+		}
+		return leadsToWord
+	}
+	walk(sut.RootNode)
+}
+
+func countWords(node *Node) int {
+	count := 0
+	if node.CompletesString {
+		count++
+	}
+	for _, child := range node.Children {
+		count += countWords(child)
+	}
+	return count
 }
 
 func TestSearch_Suggestions_Are_Empty_Given_Invalid_Term(t *testing.T) {
@@ -151,7 +265,7 @@ func TestSearch_Suggestions_Are_Empty_Given_Invalid_Term(t *testing.T) {
 
 	expected := []string{}
 
-	actual := sut.Search("invalid")
+	actual := sut.Search("invalid", 0)
 	if !reflect.DeepEqual(expected, actual) {
 		marshalAndPrint(sut)
 		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
@@ -182,11 +296,18 @@ func TestSearch_Suggestions_Are_Returned(t *testing.T) {
 	}
 	expected := []string{
 		"dvark",
+		"dvarks",
 		"dwolf",
 		"dwolves",
 		"gh",
 		"on",
+		"onic",
+		"onical",
+		"onite",
+		"onitic",
 		"rgh",
+		"rghh",
+		"u",
 	}
 
 	// verify that every word in the trie has been properly inserted and completesString flag is true too
@@ -197,15 +318,62 @@ func TestSearch_Suggestions_Are_Returned(t *testing.T) {
 		}
 	}
 
-	actual := sut.Search("aar")
-	sort.Slice(expected, func(i, j int) bool { return expected[i] > expected[j] })
-	sort.Slice(actual, func(i, j int) bool { return actual[i] > actual[j] })
+	actual := sut.Search("aar", 0)
 	if !reflect.DeepEqual(expected, actual) {
 		marshalAndPrint(sut)
 		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
 	}
 }
 
+func TestSearch_Returns_Nested_Completions_And_The_Prefix_Itself(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"cards", "car", "card", "zebra", "zed"} {
+		sut.Insert(w)
+	}
+
+	for prefix, expected := range map[string][]string{
+		"car": {"", "d", "ds"},
+		"z":   {"ebra", "ed"},
+	} {
+		actual := sut.Search(prefix, 0)
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("prefix '%s'\nexpected\n%#v\ngot\n%#v\n", prefix, expected, actual)
+		}
+	}
+}
+
+func TestSearch_Stops_At_Limit(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"ab", "abc", "abd", "abe", "ac"} {
+		sut.Insert(w)
+	}
+
+	expected := []string{"b", "bc", "bd"}
+	actual := sut.Search("a", 3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+}
+
+func TestSearch_Order_Does_Not_Depend_On_Insert_Order(t *testing.T) {
+	words := []string{"tea", "ten", "to", "tee", "t"}
+	sut := NewTrie()
+	for _, w := range words {
+		sut.Insert(w)
+	}
+	reversed := NewTrie()
+	for i := len(words) - 1; i >= 0; i-- {
+		reversed.Insert(words[i])
+	}
+
+	expected := []string{"", "ea", "ee", "en", "o"}
+	for _, actual := range [][]string{sut.Search("t", 0), reversed.Search("t", 0)} {
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+		}
+	}
+}
+
 func TestFindCompletesString_Returns_Nil_Given_Zero_Length_String(t *testing.T) {
 	sut := NewTrie()
 	sut.Insert("apple")
@@ -233,6 +401,144 @@ func TestFindCompletesString_Returns_Node_Given_Valid_Word(t *testing.T) {
 	}
 }
 
+func TestInsert_Multibyte_Words_Are_Split_By_Rune(t *testing.T) {
+	sut := NewTrie()
+	sut.Insert("café")
+	sut.Insert("naïve")
+
+	node := sut.RootNode.Child('c').Child('a').Child('f').Child('é')
+	if node == nil || !node.CompletesString || node.Key() != "café" {
+		t.Errorf("expected node for 'é' completing 'café' got '%+v'", node)
+	}
+	for _, w := range []string{"café", "naïve"} {
+		if !sut.Exists(w) {
+			t.Errorf("expected '%s' to be found after Insert", w)
+		}
+	}
+	if sut.Exists("naï") || sut.Exists("caf") {
+		t.Errorf("expected partial multibyte words to NOT be found")
+	}
+}
+
+func TestInsert_Combining_Characters_Are_Distinct_Runes(t *testing.T) {
+	sut := NewTrie()
+	composed := "caf\u00e9"
+	decomposed := "cafe\u0301"
+	sut.Insert(composed)
+	sut.Insert(decomposed)
+
+	if !sut.Exists(composed) || !sut.Exists(decomposed) {
+		t.Errorf("expected both forms of 'café' to be found")
+	}
+	if sut.Exists("cafe") {
+		t.Errorf("expected 'cafe' without the combining accent to NOT be found")
+	}
+	if n := sut.FindCompletesString(decomposed); n == nil || n.Key() != decomposed {
+		t.Errorf("expected node with Key '%s' got '%+v'", decomposed, n)
+	}
+}
+
+func TestRemove_Multibyte_Word_Leaves_Others_Intact(t *testing.T) {
+	sut := NewTrie()
+	words := []string{"café", "cafe\u0301", "naïve", "naïveté", "cab"}
+	for _, w := range words {
+		sut.Insert(w)
+	}
+
+	if _, err := sut.Remove("café"); err != nil {
+		t.Errorf("expected '%v' got '%v'", nil, err)
+	}
+	if _, err := sut.Remove("naïveté"); err != nil {
+		t.Errorf("expected '%v' got '%v'", nil, err)
+	}
+
+	if sut.Exists("café") || sut.Exists("naïveté") {
+		t.Errorf("expected removed words to NOT be found")
+	}
+	for _, w := range []string{"cafe\u0301", "naïve", "cab"} {
+		if !sut.Exists(w) {
+			t.Errorf("expected '%s' to be found after removing other words", w)
+		}
+	}
+}
+
+func TestSearch_Multibyte_Suffixes_Are_Returned(t *testing.T) {
+	sut := NewTrie()
+	sut.Insert("naïve")
+	sut.Insert("nacho")
+
+	expected := []string{"cho", "ïve"}
+	actual := sut.Search("na", 0)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nexpected\n%#v\ngot\n%#v\n", expected, actual)
+	}
+}
+
+func TestLen_Counts_Each_Word_Once(t *testing.T) {
+	sut := NewTrie()
+	for _, w := range []string{"apple", "app", " apple ", "", "apply"} {
+		sut.Insert(w)
+	}
+	sut.Remove("app")
+
+	if sut.Len() != 2 {
+		t.Errorf("expected '2' got '%d'", sut.Len())
+	}
+}
+
+func TestInsert_Trims_Words_Found_By_Exists_And_Remove(t *testing.T) {
+	sut := NewTrie()
+	sut.Insert("  app ")
+
+	if !sut.Exists("app") || !sut.Exists(" app") {
+		t.Errorf("expected 'app' to be found with or without white space")
+	}
+	if removed, err := sut.Remove("app  "); !removed || err != nil || sut.Len() != 0 {
+		t.Errorf("expected 'true', '%v' and length '0' got '%v', '%v' and '%d'", nil, removed, err, sut.Len())
+	}
+}
+
+// mapChildrenNode is the node layout the Trie used before storing only the edge letter in sorted children, kept
+// so that BenchmarkLoad_Trie_Map_Children_Baseline reports the heap used before the change next to the heap
+// BenchmarkLoad_Trie reports after it. BenchmarkLoad_Trie_Weighted adds the cost of caching TopK completions
+type mapChildrenNode struct {
+	Children        map[rune]*mapChildrenNode
+	Val             string
+	CompletesString bool
+}
+
+func BenchmarkLoad_Trie_Weighted(b *testing.B) {
+	words := loadWords(b)
+	b.ResetTimer()
+	benchmarkLoad(b, func() any {
+		sut := NewTrie()
+		for i, w := range words {
+			sut.InsertWeighted(w, i%100)
+		}
+		return sut
+	})
+}
+
+func BenchmarkLoad_Trie_Map_Children_Baseline(b *testing.B) {
+	words := loadWords(b)
+	b.ResetTimer()
+	benchmarkLoad(b, func() any {
+		root := &mapChildrenNode{Children: map[rune]*mapChildrenNode{}}
+		for _, w := range words {
+			node, val := root, ""
+			for _, letter := range w {
+				val += string(letter)
+				if _, ok := node.Children[letter]; !ok {
+					node.Children[letter] = &mapChildrenNode{Children: map[rune]*mapChildrenNode{}, Val: val}
+				}
+				node = node.Children[letter]
+			}
+			node.CompletesString = true
+		}
+		return root
+	})
+}
+
 // prints the string representation of the Trie structure in a "sort of" readable fashion
 func marshalAndPrint(t *Trie) {
 	b, err := json.MarshalIndent(t, "", " ")